@@ -1,36 +1,166 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"fmt"
 	"log"
 	"os"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 
+	"carca-cli/internal/bga"
 	"carca-cli/internal/cli"
+	"carca-cli/internal/fixtures"
 )
 
 func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "init":
+			os.Exit(runInit(os.Args[2:]))
+		case "login-check":
+			os.Exit(runLoginCheck(os.Args[2:]))
+		}
+	}
+
+	statusClearDelay := flag.Duration("status-timeout", cli.DefaultStatusClearDelay,
+		"how long status messages stay on screen (e.g. 5s)")
+	use24Hour := flag.Bool("24h", false, "show times in 24-hour format")
+	isoDates := flag.Bool("iso-dates", false, "show fixture dates as 2006-01-02 15:04")
+	forfeitScore := flag.Int("forfeit-score", fixtures.ForfeitScore,
+		"negative score that marks a forfeit in fixture CSVs (\"F\" is always accepted)")
+	byeName := flag.String("bye-name", fixtures.DefaultByeSentinel,
+		"player name that marks a bye in fixture CSVs (an empty opponent always counts as a bye)")
+	flag.Var(&fixtures.FixtureScoreMode, "score-mode",
+		"how fixture results are read for tiebreaks: \"sets\" (game difference, e.g. 2-1) or \"points\" (points scored)")
+	markers := cli.DefaultStatusMarkers
+	flag.Var(&markers, "markers",
+		"PLAYED column markers as \"played,unplayed,bye,walkover\" (e.g. \"•,·,-,W\")")
+	credsStdin := flag.Bool("creds-stdin", false,
+		"read the BGA username and password from the first two lines of stdin when not set otherwise")
+	flag.Parse()
+
+	// A zero or positive sentinel would turn ordinary scores into forfeits
+	if *forfeitScore >= 0 {
+		fmt.Printf("Error: --forfeit-score must be negative, got %d\n", *forfeitScore)
+		os.Exit(1)
+	}
+
 	// Get BGA credentials - from env, .env file, or prompt user
-	user, pass, err := cli.GetOrPromptCredentials(true)
+	user, pass, err := getCredentials(*credsStdin)
 	if err != nil {
 		fmt.Printf("Error getting BGA credentials: %v\n", err)
 		fmt.Println("\nPlease set BGA_USER and BGA_PASS environment variables or create a .env file")
 		os.Exit(1)
 	}
 
-	// Store credentials for later use (could be passed to BGA client)
-	_ = user
-	_ = pass
+	// Reuse a persisted BGA session when possible so re-runs skip login
+	client := bga.NewClient(user, pass, bga.WithAutoReauth())
+	if err := restoreOrLogin(client); err != nil {
+		fmt.Printf("Warning: could not establish BGA session: %v\n", err)
+	}
 
 	// Initialize the app coordinator TUI
 	model := cli.NewAppModel()
+	model.SetBGAClient(client)
+	model.SetStatusClearDelay(*statusClearDelay)
+	model.SetUse24Hour(*use24Hour)
+	model.SetISODates(*isoDates)
+	model.SetStatusMarkers(markers)
+	model.SetParseOptions(fixtures.WithForfeitScore(*forfeitScore), fixtures.WithByeSentinel(*byeName))
 
-	// Create a new Bubble Tea program
-	p := tea.NewProgram(model, tea.WithAltScreen())
+	// Greet new organizers with a few tips, only until they dismiss them once
+	if tipsPath, err := cli.DefaultTipsStatePath(); err == nil {
+		model.SetTipsStatePath(tipsPath)
+	}
+
+	// Create a new Bubble Tea program, reading keys from the terminal once stdin held the credentials
+	opts := []tea.ProgramOption{tea.WithAltScreen()}
+	if *credsStdin {
+		opts = append(opts, tea.WithInputTTY())
+	}
+	p := tea.NewProgram(model, opts...)
 
 	// Run the program
 	if _, err := p.Run(); err != nil {
 		log.Fatalf("Error running TUI: %v", err)
 	}
 }
+
+// getCredentials looks up the BGA credentials, falling back to stdin when asked to
+func getCredentials(credsStdin bool) (user, pass string, err error) {
+	if credsStdin {
+		return cli.GetOrReadCredentials(os.Stdin)
+	}
+
+	return cli.GetOrPromptCredentials(true)
+}
+
+// runInit handles "carca init", writing a .env template for new users
+func runInit(args []string) int {
+	initFlags := flag.NewFlagSet("init", flag.ExitOnError)
+	force := initFlags.Bool("force", false, "overwrite an existing .env file")
+	_ = initFlags.Parse(args)
+
+	if err := cli.InitEnvFile(".env", *force); err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return 1
+	}
+
+	fmt.Println("Created .env template")
+	fmt.Println()
+	fmt.Println(cli.EnvNextSteps)
+
+	return 0
+}
+
+// runLoginCheck handles "carca login-check", verifying the credentials against BGA without creating anything
+func runLoginCheck(args []string) int {
+	checkFlags := flag.NewFlagSet("login-check", flag.ExitOnError)
+	credsStdin := checkFlags.Bool("creds-stdin", false,
+		"read the BGA username and password from the first two lines of stdin when not set otherwise")
+	timeout := checkFlags.Duration("timeout", 15*time.Second, "how long to wait for BGA")
+	_ = checkFlags.Parse(args)
+
+	user, pass, err := getCredentials(*credsStdin)
+	if err != nil {
+		fmt.Printf("Error getting BGA credentials: %v\n", err)
+		return 1
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	session, err := bga.NewClient(user, pass).CheckLogin(ctx)
+	if err != nil {
+		fmt.Printf("Login check failed for %s: %v\n", user, err)
+		return 1
+	}
+
+	fmt.Printf("Login check passed for %s (session %s), logged out again\n", user, session)
+
+	return 0
+}
+
+// restoreOrLogin loads the saved session, logging in and saving a new one if it is missing or expired
+func restoreOrLogin(client *bga.Client) error {
+	path, err := bga.DefaultSessionPath()
+	if err != nil {
+		return err
+	}
+
+	if err := client.LoadSession(path); err == nil && !client.IsSessionExpired() {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	if err := client.Login(ctx); err != nil {
+		return err
+	}
+
+	return client.SaveSession(path)
+}
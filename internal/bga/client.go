@@ -1,42 +1,104 @@
 package bga
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/cookiejar"
 	"net/url"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
 // Client handles BoardGameArena API interactions
 type Client struct {
-	httpClient *http.Client
-	baseURL    string
-	username   string
-	password   string
-	sessionID  string
+	httpClient    *http.Client
+	baseURL       string
+	username      string
+	password      string
+	sessionID     string // Last seen session cookie value, kept for display and persistence
+	sessionPath   string
+	sessionExpiry time.Time
+	playerIDs     map[string]int // Resolved player IDs by username, guarded by playerIDsMu
+	limiter       *rateLimiter   // Spaces out requests so BGA does not throttle us
+	lastForm      url.Values     // Form of the last tournament creation, posted or not
+	retry         RetryConfig
+	playerIDsMu   sync.Mutex
+	dryRunCount   int  // Tournaments pretended to be created, used for synthetic IDs
+	dryRun        bool // Skip every write to BGA, answering as if it had succeeded
+	autoReauth    bool // Log in again when BGA reports the session expired
 }
 
+// ClientOption configures optional Client behavior
+type ClientOption func(*Client)
+
+// RetryConfig controls how transient BGA failures are retried
+type RetryConfig struct {
+	MaxAttempts int           // Total attempts including the first one
+	BaseDelay   time.Duration // Delay before the first retry, doubled on each attempt
+	MaxDelay    time.Duration // Upper bound for the delay between attempts
+}
+
+// DefaultRetryConfig is used when no retry option is given
+var DefaultRetryConfig = RetryConfig{
+	MaxAttempts: 3,
+	BaseDelay:   500 * time.Millisecond,
+	MaxDelay:    5 * time.Second,
+}
+
+// WithRetry overrides the retry policy for connection errors, 429 and 5xx responses
+func WithRetry(config RetryConfig) ClientOption {
+	return func(c *Client) {
+		c.retry = config
+	}
+}
+
+// DefaultBaseURL is the BGA host used unless WithBaseURL overrides it
+const DefaultBaseURL = "https://boardgamearena.com"
+
+// WithBaseURL points the client at another BGA host, such as a staging server or a test fake
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) {
+		c.baseURL = strings.TrimRight(baseURL, "/")
+	}
+}
+
+// WithDryRun makes the client build tournament forms without posting them or any other write to BGA
+func WithDryRun() ClientOption {
+	return func(c *Client) {
+		c.dryRun = true
+	}
+}
+
+// dryRunTournamentID is the first synthetic tournament ID handed out in dry-run mode
+const dryRunTournamentID = 900000000
+
 // TournamentConfig represents the configuration for creating a tournament
 type TournamentConfig struct {
-	ChampionshipName string // Championship name
-	TournamentName   string // Tournament name
-	BaseDate         string // Base date (YYYY-MM-DD)
-	BaseDateTime     string // Base date time (HH:MM)
-	Division         string // Division name (Elite, Platinum A, etc.)
-	LocalPlayer      string // Local player (home)
-	VisitorPlayer    string // Visitor player (away)
-	GameID           int    // 1 for Carcassonne
-	MaxPlayers       int    // Maximum participants (2 for 1v1)
-	MinPlayers       int    // Minimum participants (2 for 1v1)
-	GameDuration     int    // Game duration in seconds (1800 for 30 min)
-	MatchesCount     int    // Number of matches (3 for best-of-3)
-	RoundNumber      int    // Round number
-	MatchNumber      int    // Match number from fixture
+	Expansions       []Expansion // Enabled Carcassonne expansions, none by default
+	ChampionshipName string      // Championship name
+	TournamentName   string      // Tournament name
+	BaseDate         string      // Base date (YYYY-MM-DD)
+	BaseDateTime     string      // Base date time (HH:MM)
+	Division         string      // Division name (Elite, Platinum A, etc.)
+	RegistrationType string      // Who can register, one of BGA's registration types
+	LocalPlayer      string      // Local player (home)
+	VisitorPlayer    string      // Visitor player (away)
+	GameID           int         // 1 for Carcassonne
+	MaxPlayers       int         // Maximum participants (2 for 1v1)
+	MinPlayers       int         // Minimum participants (2 for 1v1)
+	GameDuration     int         // Game duration in seconds (1800 for 30 min)
+	MatchesCount     int         // Number of matches (3 for best-of-3)
+	RoundNumber      int         // Round number
+	MatchNumber      int         // Match number from fixture
+	Season           int         // Season the championship belongs to
+	StageType        StageType   // Stage format, Swiss by default
 }
 
 // TournamentResponse represents the response from BGA tournament creation
@@ -48,19 +110,139 @@ type TournamentResponse struct {
 }
 
 // NewClient creates a new BGA client
-func NewClient(username, password string) *Client {
-	return &Client{
+func NewClient(username, password string, opts ...ClientOption) *Client {
+	client := &Client{
 		httpClient: &http.Client{
 			Timeout: 30 * time.Second,
+			Jar:     newCookieJar(),
 		},
-		baseURL:  "https://boardgamearena.com",
-		username: username,
-		password: password,
+		baseURL:   DefaultBaseURL,
+		username:  username,
+		password:  password,
+		retry:     DefaultRetryConfig,
+		limiter:   newRateLimiter(DefaultRateLimit),
+		playerIDs: make(map[string]int),
+	}
+
+	for _, opt := range opts {
+		opt(client)
+	}
+
+	return client
+}
+
+// sessionCookieNames lists the cookies BGA uses to identify a logged-in session
+var sessionCookieNames = []string{"TournamentSession", "PHPSESSID"}
+
+// newCookieJar creates an empty cookie jar for BGA requests
+func newCookieJar() http.CookieJar {
+	// cookiejar.New only fails when given a public suffix list that errors, which nil never does
+	jar, _ := cookiejar.New(nil)
+	return jar
+}
+
+// sessionCookie returns the BGA session cookie held in the jar for the base URL, or nil
+func (c *Client) sessionCookie() *http.Cookie {
+	u, err := url.Parse(c.baseURL)
+	if err != nil {
+		return nil
+	}
+
+	for _, cookie := range c.httpClient.Jar.Cookies(u) {
+		for _, name := range sessionCookieNames {
+			if cookie.Name == name && cookie.Value != "" {
+				return cookie
+			}
+		}
+	}
+
+	return nil
+}
+
+// setSessionCookie stores a known session ID in the jar, e.g. when restoring a persisted session
+func (c *Client) setSessionCookie(sessionID string) error {
+	u, err := url.Parse(c.baseURL)
+	if err != nil {
+		return fmt.Errorf("invalid base URL: %w", err)
+	}
+
+	c.httpClient.Jar.SetCookies(u, []*http.Cookie{{Name: "PHPSESSID", Value: sessionID, Path: "/"}})
+	c.sessionID = sessionID
+	return nil
+}
+
+// doWithRetry performs the request built by newRequest, retrying transient failures with exponential backoff
+// The request is rebuilt on every attempt so that its body can be sent again
+// Every attempt, retries included, waits for the client's rate limit
+func (c *Client) doWithRetry(ctx context.Context, newRequest func() (*http.Request, error)) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		req, err := newRequest()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := c.limiter.wait(ctx); err != nil {
+			return nil, err
+		}
+
+		resp, err := c.httpClient.Do(req)
+		if err == nil && !isRetryableStatus(resp.StatusCode) {
+			return resp, nil
+		}
+
+		if attempt >= c.retry.MaxAttempts {
+			return resp, err
+		}
+
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		if err := sleepContext(ctx, c.retry.backoff(attempt)); err != nil {
+			return nil, err
+		}
 	}
 }
 
+// sleepContext waits for the given duration or until the context is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// isRetryableStatus reports whether a response status is worth retrying
+func isRetryableStatus(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode >= http.StatusInternalServerError
+}
+
+// backoff returns the delay to wait after the given failed attempt
+func (r RetryConfig) backoff(attempt int) time.Duration {
+	delay := r.BaseDelay << (attempt - 1)
+	if r.MaxDelay > 0 && (delay > r.MaxDelay || delay <= 0) {
+		delay = r.MaxDelay
+	}
+
+	return delay
+}
+
 // Login authenticates with BGA and establishes a session
-func (c *Client) Login() error {
+func (c *Client) Login(ctx context.Context) error {
+	if c.username == "" || c.password == "" {
+		return &ValidationError{Field: "credentials", Message: "username and password are required"}
+	}
+
 	loginURL := c.baseURL + "/account/account/login.html"
 
 	// Prepare login form data
@@ -70,39 +252,43 @@ func (c *Client) Login() error {
 	formData.Set("form_id", "connection_form")
 	formData.Set("request_id", strconv.FormatInt(time.Now().Unix(), 10))
 
-	req, err := http.NewRequest("POST", loginURL, strings.NewReader(formData.Encode()))
-	if err != nil {
-		return fmt.Errorf("failed to create login request: %w", err)
-	}
+	resp, err := c.doWithRetry(ctx, func() (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, "POST", loginURL, strings.NewReader(formData.Encode()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create login request: %w", err)
+		}
 
-	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
-	req.Header.Set("User-Agent", "Carcassonne Tournament Manager/1.0")
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.Header.Set("User-Agent", "Carcassonne Tournament Manager/1.0")
 
-	resp, err := c.httpClient.Do(req)
+		return req, nil
+	})
 	if err != nil {
 		return fmt.Errorf("failed to perform login request: %w", err)
 	}
 	defer resp.Body.Close()
 
-	// Extract session information from cookies
-	for _, cookie := range resp.Cookies() {
-		if cookie.Name == "TournamentSession" || cookie.Name == "PHPSESSID" {
-			c.sessionID = cookie.Value
-			break
-		}
+	// The jar has stored every cookie BGA set; remember the session one for display
+	cookie := c.sessionCookie()
+	if cookie == nil {
+		return fmt.Errorf("failed to authenticate: no session cookie received: %w", ErrInvalidCredentials)
 	}
 
-	if c.sessionID == "" {
-		return fmt.Errorf("failed to authenticate: no session cookie received")
-	}
+	c.sessionID = cookie.Value
+
+	c.sessionExpiry = now().Add(sessionLifetime)
 
 	return nil
 }
 
 // CreateTournament creates a new Swiss tournament on BGA
-func (c *Client) CreateTournament(config *TournamentConfig) (*TournamentResponse, error) {
-	if c.sessionID == "" {
-		return nil, fmt.Errorf("not authenticated: call Login() first")
+func (c *Client) CreateTournament(ctx context.Context, config *TournamentConfig) (*TournamentResponse, error) {
+	if !c.IsAuthenticated() {
+		return nil, ErrNotAuthenticated
+	}
+
+	if err := validateRegistrationType(config.registrationType()); err != nil {
+		return nil, err
 	}
 
 	tournamentURL := c.baseURL + "/newtournament/newtournament/create.html"
@@ -112,41 +298,67 @@ func (c *Client) CreateTournament(config *TournamentConfig) (*TournamentResponse
 	formData.Set("form_id", "createnewtournament")
 	formData.Set("dojo.preventCache", strconv.FormatInt(time.Now().UnixMilli(), 10))
 
-	return c.submitTournamentRequest(tournamentURL, formData)
+	c.lastForm = formData
+
+	if c.dryRun {
+		tournamentID := dryRunTournamentID + c.dryRunCount
+		c.dryRunCount++
+
+		return &TournamentResponse{
+			Success:      true,
+			TournamentID: tournamentID,
+			Link:         fmt.Sprintf("%s/tournament?id=%d", c.baseURL, tournamentID),
+		}, nil
+	}
+
+	return c.submitTournamentRequest(ctx, tournamentURL, formData)
 }
 
-// CreateSwissTournament creates a best-of-3 Swiss tournament for two players
+// LastFormData returns a copy of the form built by the last CreateTournament call, nil before any
+func (c *Client) LastFormData() url.Values {
+	if c.lastForm == nil {
+		return nil
+	}
+
+	form := make(url.Values, len(c.lastForm))
+	for field, values := range c.lastForm {
+		form[field] = append([]string(nil), values...)
+	}
+
+	return form
+}
+
+// FormOptionSummary lists the stage type, game options and mode options a Swiss duel submits with the given options
+// Each entry reads "field=value", stage type first and the rest sorted, to compare against BGA's tournament form
+func FormOptionSummary(opts ...TournamentOption) []string {
+	baseDate, baseDateTime := defaultSchedule()
+	config := newSwissTournamentConfig("", "", "", 0, 0, baseDate, baseDateTime, opts...)
+	form := (&Client{}).buildTournamentForm(config)
+
+	var options []string
+	for field := range form {
+		if strings.HasPrefix(field, "gameoption_") || strings.HasPrefix(field, "mode_option_") {
+			options = append(options, field+"="+form.Get(field))
+		}
+	}
+	slices.Sort(options)
+
+	return append([]string{"stage_type=" + form.Get("stage_type")}, options...)
+}
+
+// CreateSwissTournament creates a Swiss tournament for two players, best-of-3 unless options say otherwise
 func (c *Client) CreateSwissTournament(
+	ctx context.Context,
 	division, homePlayer, awayPlayer string,
 	roundNumber, matchNumber int,
+	opts ...TournamentOption,
 ) (*TournamentResponse, error) {
-	// Get current date for tournament scheduling
-	now := time.Now()
-	baseDate := now.Format("2006-01-02")
-	baseDateTime := "21:00" // Default to 9 PM
-
-	// Format championship and tournament names according to requirements
-	championshipName := fmt.Sprintf("Division %s - 1era Temporada", division)
-	tournamentName := fmt.Sprintf("%d Fecha - Duelo %d - %s vs %s", roundNumber, matchNumber, homePlayer, awayPlayer)
-
-	config := &TournamentConfig{
-		GameID:           1,                // Carcassonne game ID
-		ChampionshipName: championshipName, // Division X - 1era Temporada
-		TournamentName:   tournamentName,   // X Fecha - Duelo Y - Player1 vs Player2
-		MaxPlayers:       2,                // Exactly 2 players
-		MinPlayers:       2,                // Minimum 2 players
-		BaseDate:         baseDate,         // Current date
-		BaseDateTime:     baseDateTime,     // 21:00
-		GameDuration:     1800,             // 30 minutes (1800 seconds)
-		MatchesCount:     3,                // Best-of-3
-		Division:         division,         // Division name
-		RoundNumber:      roundNumber,      // Round number
-		MatchNumber:      matchNumber,      // Match number from fixture
-		LocalPlayer:      homePlayer,       // Home/local player
-		VisitorPlayer:    awayPlayer,       // Away/visitor player
-	}
-
-	return c.CreateTournament(config)
+	baseDate, baseDateTime := defaultSchedule()
+	config := newSwissTournamentConfig(
+		division, homePlayer, awayPlayer, roundNumber, matchNumber, baseDate, baseDateTime, opts...,
+	)
+
+	return c.CreateTournament(ctx, config)
 }
 
 // buildTournamentForm constructs the form data for tournament creation
@@ -157,8 +369,15 @@ func (c *Client) buildTournamentForm(config *TournamentConfig) url.Values {
 	c.setRegistrationSettings(formData, config)
 	c.setTableAccessLevels(formData)
 	c.setGeneralSettings(formData, config)
-	c.setCarcassonneGameOptions(formData)
-	c.setSwissSystemOptions(formData, config)
+	c.setCarcassonneGameOptions(formData, config)
+
+	switch config.StageType {
+	case StageSingleElimination:
+		c.setSingleEliminationOptions(formData, config)
+	default:
+		c.setSwissSystemOptions(formData, config)
+	}
+
 	c.setPlayerConfirmation(formData)
 
 	return formData
@@ -175,7 +394,7 @@ func (c *Client) setBasicTournamentSettings(formData url.Values, config *Tournam
 
 // setRegistrationSettings configures tournament registration options
 func (c *Client) setRegistrationSettings(formData url.Values, config *TournamentConfig) {
-	formData.Set("registration_type", "invitation_only")
+	formData.Set("registration_type", config.registrationType())
 	formData.Set("registration_group", "0")
 	formData.Set("registration_starts", "30")
 	formData.Set("min_players", strconv.Itoa(config.MinPlayers))
@@ -195,22 +414,27 @@ func (c *Client) setTableAccessLevels(formData url.Values) {
 func (c *Client) setGeneralSettings(formData url.Values, config *TournamentConfig) {
 	formData.Set("karma", "1")
 	formData.Set("restrictedCountries", "")
-	formData.Set("stage_type", "swissSystemV2")
+	formData.Set("stage_type", config.StageType.formValue())
 	formData.Set("game_max_duration", strconv.Itoa(config.GameDuration))
 	formData.Set("players_out_of_time", "vote_kick")
 }
 
 // setCarcassonneGameOptions sets game-specific options for Carcassonne
-func (c *Client) setCarcassonneGameOptions(formData url.Values) {
+func (c *Client) setCarcassonneGameOptions(formData url.Values, config *TournamentConfig) {
 	// Field scoring: international (3pts per city)
 	formData.Set("gameoption_200", "5")
 	// City scoring: international (4pts per two tile city)
 	formData.Set("gameoption_204", "900")
-	// No River expansion
-	formData.Set("gameoption_201", "0")
-	// No Inns & Cathedrals
-	formData.Set("gameoption_206", "0")
-	// No other expansions
+	// Expansions are off unless the config enables them
+	for _, option := range expansionGameOptions {
+		formData.Set(option, "0")
+	}
+	for _, expansion := range config.Expansions {
+		if option, ok := expansionGameOptions[expansion]; ok {
+			formData.Set(option, "1")
+		}
+	}
+	// Other expansions are not supported yet
 	formData.Set("gameoption_106", "0")
 	formData.Set("gameoption_103", "0")
 	formData.Set("gameoption_104", "0")
@@ -226,7 +450,7 @@ func (c *Client) setCarcassonneGameOptions(formData url.Values) {
 
 // setSwissSystemOptions configures Swiss system tournament options
 func (c *Client) setSwissSystemOptions(formData url.Values, config *TournamentConfig) {
-	// Swiss System V2 mode options (best-of-3)
+	// Swiss System V2 mode options; 103 is the number of games per duel
 	formData.Set("mode_option_swissSystemV2_100", "1")
 	formData.Set("mode_option_swissSystemV2_101", "100")
 	formData.Set("mode_option_swissSystemV2_102", "1")
@@ -262,30 +486,37 @@ func (c *Client) setSwissSystemOptions(formData url.Values, config *TournamentCo
 	formData.Set("stage_2_mode_option_twoStage_103", "1")
 }
 
+// setSingleEliminationOptions configures single-elimination bracket options
+func (c *Client) setSingleEliminationOptions(formData url.Values, config *TournamentConfig) {
+	// Single elimination mode options; 100 is the number of games per duel, 101 disables the third place match
+	formData.Set("mode_option_simple_100", strconv.Itoa(config.MatchesCount))
+	formData.Set("mode_option_simple_101", "0")
+}
+
 // setPlayerConfirmation requires player confirmation for tournament participation
 func (c *Client) setPlayerConfirmation(formData url.Values) {
 	formData.Set("players", "confirm_players")
 }
 
 // submitTournamentRequest handles the HTTP request and response parsing
-func (c *Client) submitTournamentRequest(tournamentURL string, formData url.Values) (*TournamentResponse, error) {
-	req, err := http.NewRequest("POST", tournamentURL, strings.NewReader(formData.Encode()))
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
+func (c *Client) submitTournamentRequest(
+	ctx context.Context,
+	tournamentURL string,
+	formData url.Values,
+) (*TournamentResponse, error) {
+	resp, body, err := c.doReadingBody(ctx, func() (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, "POST", tournamentURL, strings.NewReader(formData.Encode()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
 
-	c.setRequestHeaders(req)
+		c.setRequestHeaders(req)
 
-	resp, err := c.httpClient.Do(req)
+		return req, nil
+	})
 	if err != nil {
 		return nil, fmt.Errorf("tournament creation request failed: %w", err)
 	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
-	}
 
 	if resp.StatusCode != http.StatusOK {
 		return nil, fmt.Errorf("tournament creation failed with status %d: %s", resp.StatusCode, string(body))
@@ -298,7 +529,6 @@ func (c *Client) submitTournamentRequest(tournamentURL string, formData url.Valu
 func (c *Client) setRequestHeaders(req *http.Request) {
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	req.Header.Set("User-Agent", "Carcassonne Tournament Manager/1.0")
-	req.Header.Set("Cookie", fmt.Sprintf("PHPSESSID=%s", c.sessionID))
 }
 
 // parseTournamentResponse extracts tournament information from BGA response
@@ -355,68 +585,69 @@ func (c *Client) extractTournamentID(bodyStr string) int {
 	return 0
 }
 
-// CreateSwissTournamentWithDateTime creates a best-of-3 Swiss tournament for two players with specific datetime
+// CreateSwissTournamentWithDateTime creates a Swiss tournament for two players at a specific datetime
 func (c *Client) CreateSwissTournamentWithDateTime(
+	ctx context.Context,
 	division, homePlayer, awayPlayer string,
 	roundNumber, matchNumber int,
 	scheduledTime time.Time,
+	opts ...TournamentOption,
 ) (*TournamentResponse, error) {
-	// Format the scheduled time for BGA
-	baseDate := scheduledTime.Format("2006-01-02")
-	baseDateTime := scheduledTime.Format("15:04")
-
-	// Format championship and tournament names according to requirements
-	championshipName := fmt.Sprintf("Division %s - 1era Temporada", division)
-	tournamentName := fmt.Sprintf("%d Fecha - Duelo %d - %s vs %s", roundNumber, matchNumber, homePlayer, awayPlayer)
-
-	config := &TournamentConfig{
-		GameID:           1,                // Carcassonne game ID
-		ChampionshipName: championshipName, // Division X - 1era Temporada
-		TournamentName:   tournamentName,   // X Fecha - Duelo Y - Player1 vs Player2
-		MaxPlayers:       2,                // Exactly 2 players
-		MinPlayers:       2,                // Minimum 2 players
-		BaseDate:         baseDate,         // Scheduled date
-		BaseDateTime:     baseDateTime,     // Scheduled time
-		GameDuration:     1800,             // 30 minutes (1800 seconds)
-		MatchesCount:     3,                // Best-of-3
-		Division:         division,         // Division name
-		RoundNumber:      roundNumber,      // Round number
-		MatchNumber:      matchNumber,      // Match number from fixture
-		LocalPlayer:      homePlayer,       // Home/local player
-		VisitorPlayer:    awayPlayer,       // Away/visitor player
-	}
-
-	return c.CreateTournament(config)
+	config := newSwissTournamentConfig(
+		division, homePlayer, awayPlayer, roundNumber, matchNumber,
+		scheduledTime.Format("2006-01-02"), scheduledTime.Format("15:04"), opts...,
+	)
+
+	return c.CreateTournament(ctx, config)
+}
+
+// CreateKnockoutTournament creates a single-elimination bracket for up to maxPlayers at a specific datetime
+func (c *Client) CreateKnockoutTournament(
+	ctx context.Context,
+	division, tournamentName string,
+	maxPlayers int,
+	scheduledTime time.Time,
+	opts ...TournamentOption,
+) (*TournamentResponse, error) {
+	config := newKnockoutTournamentConfig(
+		division, tournamentName, maxPlayers,
+		scheduledTime.Format("2006-01-02"), scheduledTime.Format("15:04"), opts...,
+	)
+
+	return c.CreateTournament(ctx, config)
 }
 
 // GetTournamentStatus retrieves the current status of a tournament
-func (c *Client) GetTournamentStatus(tournamentID int) (*TournamentStatus, error) {
-	if c.sessionID == "" {
-		return nil, fmt.Errorf("not authenticated: call Login() first")
+func (c *Client) GetTournamentStatus(ctx context.Context, tournamentID int) (*TournamentStatus, error) {
+	if !c.IsAuthenticated() {
+		return nil, ErrNotAuthenticated
 	}
 
 	statusURL := fmt.Sprintf("%s/tournament/tournament/tournamentStatus.html?id=%d", c.baseURL, tournamentID)
 
-	req, err := http.NewRequest("GET", statusURL, http.NoBody)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create status request: %w", err)
-	}
+	resp, body, err := c.doReadingBody(ctx, func() (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, "GET", statusURL, http.NoBody)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create status request: %w", err)
+		}
 
-	req.Header.Set("User-Agent", "Carcassonne Tournament Manager/1.0")
-	req.Header.Set("Cookie", fmt.Sprintf("PHPSESSID=%s", c.sessionID))
+		c.setRequestHeaders(req)
 
-	resp, err := c.httpClient.Do(req)
+		return req, nil
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get tournament status: %w", err)
 	}
-	defer resp.Body.Close()
 
-	var status TournamentStatus
-	if err := json.NewDecoder(resp.Body).Decode(&status); err != nil {
-		return nil, fmt.Errorf("failed to parse tournament status: %w", err)
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("%w: %d", ErrTournamentNotFound, tournamentID)
 	}
 
-	return &status, nil
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("tournament status failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	return parseTournamentStatus(body)
 }
 
 // TournamentStatus represents the current status of a tournament
@@ -442,12 +673,16 @@ type MatchStatus struct {
 
 // IsAuthenticated checks if the client has a valid session
 func (c *Client) IsAuthenticated() bool {
-	return c.sessionID != ""
+	return c.sessionCookie() != nil
 }
 
 // Logout terminates the current session
 func (c *Client) Logout() error {
-	if c.sessionID == "" {
+	if err := c.removeSessionFile(); err != nil {
+		return err
+	}
+
+	if !c.IsAuthenticated() {
 		return nil // Already logged out
 	}
 
@@ -458,75 +693,232 @@ func (c *Client) Logout() error {
 		return fmt.Errorf("failed to create logout request: %w", err)
 	}
 
-	req.Header.Set("Cookie", fmt.Sprintf("PHPSESSID=%s", c.sessionID))
-
 	_, err = c.httpClient.Do(req)
 	if err != nil {
 		return fmt.Errorf("failed to logout: %w", err)
 	}
 
+	c.httpClient.Jar = newCookieJar()
 	c.sessionID = ""
+	c.sessionExpiry = time.Time{}
 	return nil
 }
 
-// LaunchTournament launches a created tournament using GET request
-func (c *Client) LaunchTournament(tournamentID int) error {
-	if c.sessionID == "" {
-		return fmt.Errorf("not authenticated: call Login() first")
-	}
+// ajaxStatusResponse is the generic JSON reply of BGA action endpoints
+type ajaxStatusResponse struct {
+	Status any    `json:"status"` // 1 on success; BGA sends it as a number or a string
+	Error  string `json:"error"`
+}
 
-	timestamp := time.Now().Unix()
-	launchURL := fmt.Sprintf("%s/tournament/tournament/launchtournament.html?id=%d&dojo.preventCache=%d",
-		c.baseURL, tournamentID, timestamp)
+// succeeded reports whether BGA accepted the action
+func (r *ajaxStatusResponse) succeeded() bool {
+	return fmt.Sprint(r.Status) == "1"
+}
 
-	req, err := http.NewRequest("GET", launchURL, http.NoBody)
-	if err != nil {
-		return fmt.Errorf("failed to create launch request: %w", err)
+// postAction submits a form to a BGA action endpoint and decodes its status reply
+// In dry-run mode nothing is sent and the action is reported as accepted
+func (c *Client) postAction(ctx context.Context, endpoint string, formData url.Values) (*ajaxStatusResponse, error) {
+	if c.dryRun {
+		return &ajaxStatusResponse{Status: 1}, nil
 	}
 
-	c.setRequestHeaders(req)
+	formData.Set("dojo.preventCache", strconv.FormatInt(time.Now().Unix(), 10))
+
+	resp, err := c.doWithRetry(ctx, func() (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, "POST", c.baseURL+endpoint, strings.NewReader(formData.Encode()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
 
-	resp, err := c.httpClient.Do(req)
+		c.setRequestHeaders(req)
+
+		return req, nil
+	})
 	if err != nil {
-		return fmt.Errorf("tournament launch request failed: %w", err)
+		return nil, fmt.Errorf("request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
 	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("tournament launch failed with status %d: %s", resp.StatusCode, string(body))
+		return nil, fmt.Errorf("failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var status ajaxStatusResponse
+	if err := json.Unmarshal(body, &status); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &status, nil
+}
+
+// rejectionReason returns BGA's explanation for a refused action, or fallback when it gave none
+func (r *ajaxStatusResponse) rejectionReason(fallback string) string {
+	if r.Error != "" {
+		return r.Error
+	}
+	return fallback
+}
+
+// LaunchTournament launches a created tournament so players can join
+func (c *Client) LaunchTournament(ctx context.Context, tournamentID int) error {
+	if !c.IsAuthenticated() {
+		return ErrNotAuthenticated
+	}
+
+	formData := url.Values{}
+	formData.Set("id", strconv.Itoa(tournamentID))
+
+	status, err := c.postAction(ctx, "/tournament/tournament/launchTournament.html", formData)
+	if err != nil {
+		return fmt.Errorf("tournament launch %w", err)
+	}
+
+	if !status.succeeded() {
+		return fmt.Errorf("tournament %d cannot be launched: %s",
+			tournamentID, status.rejectionReason("BGA rejected the launch"))
 	}
 
 	return nil
 }
 
-// InvitePlayer invites a player to a tournament using GET request
-func (c *Client) InvitePlayer(tournamentID int, playerID string) error {
-	if c.sessionID == "" {
-		return fmt.Errorf("not authenticated: call Login() first")
+// InvitePlayer adds a player, identified by their numeric BGA ID, to a launched tournament
+func (c *Client) InvitePlayer(ctx context.Context, tournamentID, playerID int) error {
+	if !c.IsAuthenticated() {
+		return ErrNotAuthenticated
 	}
 
-	timestamp := time.Now().Unix()
-	inviteURL := fmt.Sprintf("%s/tournament/tournament/invitePlayer.html?id=%d&player=%s&dojo.preventCache=%d",
-		c.baseURL, tournamentID, playerID, timestamp)
+	formData := url.Values{}
+	formData.Set("id", strconv.Itoa(tournamentID))
+	formData.Set("player", strconv.Itoa(playerID))
 
-	req, err := http.NewRequest("GET", inviteURL, http.NoBody)
+	status, err := c.postAction(ctx, "/tournament/tournament/addPlayerToTournament.html", formData)
 	if err != nil {
-		return fmt.Errorf("failed to create invite request: %w", err)
+		return fmt.Errorf("player invite %w", err)
+	}
+
+	if !status.succeeded() {
+		return fmt.Errorf("player %d cannot be invited to tournament %d: %s",
+			playerID, tournamentID, status.rejectionReason("BGA rejected the invitation"))
+	}
+
+	return nil
+}
+
+// ReportMatchResult submits the final score of a game table in a tournament
+func (c *Client) ReportMatchResult(ctx context.Context, tournamentID, gameTableID, homeScore, awayScore int) error {
+	if !c.IsAuthenticated() {
+		return ErrNotAuthenticated
+	}
+
+	formData := url.Values{}
+	formData.Set("id", strconv.Itoa(tournamentID))
+	formData.Set("table", strconv.Itoa(gameTableID))
+	formData.Set("score1", strconv.Itoa(homeScore))
+	formData.Set("score2", strconv.Itoa(awayScore))
+
+	status, err := c.postAction(ctx, "/tournament/tournament/reportResult.html", formData)
+	if err != nil {
+		return fmt.Errorf("match result report %w", err)
+	}
+
+	if !status.succeeded() {
+		return fmt.Errorf("result for table %d of tournament %d was not accepted: %s",
+			gameTableID, tournamentID, status.rejectionReason("BGA rejected the result"))
+	}
+
+	return nil
+}
+
+// playerSearchResponse is the reply of the BGA player lookup endpoint
+type playerSearchResponse struct {
+	Data struct {
+		Items []struct {
+			ID       json.Number `json:"id"`
+			FullName string      `json:"fullname"`
+		} `json:"items"`
+	} `json:"data"`
+	ajaxStatusResponse
+}
+
+// ResolvePlayerID looks up the numeric BGA player ID for a username, caching results per client
+func (c *Client) ResolvePlayerID(ctx context.Context, username string) (int, error) {
+	if !c.IsAuthenticated() {
+		return 0, ErrNotAuthenticated
+	}
+
+	if username == "" {
+		return 0, &ValidationError{Field: "username", Message: "player username is required"}
+	}
+
+	if id, ok := c.cachedPlayerID(username); ok {
+		return id, nil
 	}
 
-	c.setRequestHeaders(req)
+	lookupURL := fmt.Sprintf("%s/player/player/findplayer.html?q=%s", c.baseURL, url.QueryEscape(username))
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doWithRetry(ctx, func() (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, "GET", lookupURL, http.NoBody)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create player lookup request: %w", err)
+		}
+
+		c.setRequestHeaders(req)
+
+		return req, nil
+	})
 	if err != nil {
-		return fmt.Errorf("player invite request failed: %w", err)
+		return 0, fmt.Errorf("player lookup request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("player invite failed with status %d: %s", resp.StatusCode, string(body))
+		return 0, fmt.Errorf("player lookup failed with status %d: %s", resp.StatusCode, string(body))
 	}
 
-	return nil
+	var search playerSearchResponse
+	if err := json.NewDecoder(resp.Body).Decode(&search); err != nil {
+		return 0, fmt.Errorf("failed to parse player lookup response: %w", err)
+	}
+
+	// The lookup matches prefixes, so only accept the exact username
+	var matches []json.Number
+	for _, item := range search.Data.Items {
+		if strings.EqualFold(item.FullName, username) {
+			matches = append(matches, item.ID)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return 0, fmt.Errorf("player %q not found on BGA", username)
+	case 1:
+	default:
+		return 0, fmt.Errorf("player %q is ambiguous: %d players match", username, len(matches))
+	}
+
+	id, err := strconv.Atoi(matches[0].String())
+	if err != nil {
+		return 0, fmt.Errorf("invalid player ID %q for %q", matches[0], username)
+	}
+
+	c.playerIDsMu.Lock()
+	c.playerIDs[username] = id
+	c.playerIDsMu.Unlock()
+
+	return id, nil
+}
+
+// cachedPlayerID returns the ID resolved earlier for a username, since lookups run from concurrent commands
+func (c *Client) cachedPlayerID(username string) (int, bool) {
+	c.playerIDsMu.Lock()
+	defer c.playerIDsMu.Unlock()
+
+	id, ok := c.playerIDs[username]
+	return id, ok
 }
@@ -1,7 +1,17 @@
 package bga
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 )
@@ -38,7 +48,9 @@ func TestClient_IsAuthenticated(t *testing.T) {
 	}
 
 	// Simulate authentication by setting session ID
-	client.sessionID = "test-session-id"
+	if err := client.setSessionCookie("test-session-id"); err != nil {
+		t.Fatalf("Failed to set session cookie: %v", err)
+	}
 
 	if !client.IsAuthenticated() {
 		t.Error("Client should be authenticated after setting session ID")
@@ -111,7 +123,7 @@ func TestMockClient_Login(t *testing.T) {
 			mockClient := NewMockClient(tc.username, tc.password)
 			mockClient.SetShouldFailLogin(tc.shouldFail)
 
-			err := mockClient.Login()
+			err := mockClient.Login(context.Background())
 
 			if tc.expectedError && err == nil {
 				t.Error("Expected login to fail, but it succeeded")
@@ -132,19 +144,19 @@ func TestMockClient_CreateSwissTournament(t *testing.T) {
 	mockClient := NewMockClient("testuser", "testpass")
 
 	// Should fail when not authenticated
-	_, err := mockClient.CreateSwissTournament("Elite", "player1", "player2", 1, 15)
+	_, err := mockClient.CreateSwissTournament(context.Background(), "Elite", "player1", "player2", 1, 15)
 	if err == nil {
 		t.Error("Expected tournament creation to fail when not authenticated")
 	}
 
 	// Login first
-	err = mockClient.Login()
+	err = mockClient.Login(context.Background())
 	if err != nil {
 		t.Fatalf("Failed to login: %v", err)
 	}
 
 	// Test successful tournament creation
-	resp, err := mockClient.CreateSwissTournament("Elite", "player1", "player2", 1, 15)
+	resp, err := mockClient.CreateSwissTournament(context.Background(), "Elite", "player1", "player2", 1, 15)
 	if err != nil {
 		t.Fatalf("Failed to create tournament: %v", err)
 	}
@@ -169,7 +181,7 @@ func TestMockClient_CreateSwissTournament(t *testing.T) {
 
 func TestMockClient_CreateTournament_ValidationErrors(t *testing.T) {
 	mockClient := NewMockClient("testuser", "testpass")
-	err := mockClient.Login()
+	err := mockClient.Login(context.Background())
 	if err != nil {
 		t.Fatalf("Failed to login: %v", err)
 	}
@@ -207,7 +219,7 @@ func TestMockClient_CreateTournament_ValidationErrors(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			resp, err := mockClient.CreateTournament(&tc.config)
+			resp, err := mockClient.CreateTournament(context.Background(), &tc.config)
 			if err != nil {
 				t.Fatalf("Unexpected error: %v", err)
 			}
@@ -225,19 +237,19 @@ func TestMockClient_CreateTournament_ValidationErrors(t *testing.T) {
 
 func TestMockClient_GetTournamentStatus(t *testing.T) {
 	mockClient := NewMockClient("testuser", "testpass")
-	err := mockClient.Login()
+	err := mockClient.Login(context.Background())
 	if err != nil {
 		t.Fatalf("Failed to login: %v", err)
 	}
 
 	// Create a tournament first
-	resp, err := mockClient.CreateSwissTournament("Elite", "player1", "player2", 1, 15)
+	resp, err := mockClient.CreateSwissTournament(context.Background(), "Elite", "player1", "player2", 1, 15)
 	if err != nil {
 		t.Fatalf("Failed to create tournament: %v", err)
 	}
 
 	// Get tournament status
-	status, err := mockClient.GetTournamentStatus(resp.TournamentID)
+	status, err := mockClient.GetTournamentStatus(context.Background(), resp.TournamentID)
 	if err != nil {
 		t.Fatalf("Failed to get tournament status: %v", err)
 	}
@@ -264,7 +276,7 @@ func TestMockClient_GetTournamentStatus(t *testing.T) {
 	}
 
 	// Test non-existent tournament
-	_, err = mockClient.GetTournamentStatus(999999)
+	_, err = mockClient.GetTournamentStatus(context.Background(), 999999)
 	if err == nil {
 		t.Error("Expected error for non-existent tournament")
 	}
@@ -272,13 +284,13 @@ func TestMockClient_GetTournamentStatus(t *testing.T) {
 
 func TestMockClient_SimulateMatchResult(t *testing.T) {
 	mockClient := NewMockClient("testuser", "testpass")
-	err := mockClient.Login()
+	err := mockClient.Login(context.Background())
 	if err != nil {
 		t.Fatalf("Failed to login: %v", err)
 	}
 
 	// Create a tournament
-	resp, err := mockClient.CreateSwissTournament("Elite", "player1", "player2", 1, 15)
+	resp, err := mockClient.CreateSwissTournament(context.Background(), "Elite", "player1", "player2", 1, 15)
 	if err != nil {
 		t.Fatalf("Failed to create tournament: %v", err)
 	}
@@ -290,7 +302,7 @@ func TestMockClient_SimulateMatchResult(t *testing.T) {
 	}
 
 	// Check updated status
-	status, err := mockClient.GetTournamentStatus(resp.TournamentID)
+	status, err := mockClient.GetTournamentStatus(context.Background(), resp.TournamentID)
 	if err != nil {
 		t.Fatalf("Failed to get tournament status: %v", err)
 	}
@@ -319,11 +331,35 @@ func TestMockClient_SimulateMatchResult(t *testing.T) {
 	}
 }
 
+func TestMockClient_ListTournamentsSorted(t *testing.T) {
+	mockClient := NewMockClient("testuser", "testpass")
+	if err := mockClient.Login(context.Background()); err != nil {
+		t.Fatalf("Failed to login: %v", err)
+	}
+
+	for i := 1; i <= 5; i++ {
+		if _, err := mockClient.CreateSwissTournament(context.Background(), "Elite", "p1", "p2", 1, i); err != nil {
+			t.Fatalf("Failed to create tournament %d: %v", i, err)
+		}
+	}
+
+	tournaments := mockClient.ListTournamentsSorted()
+	if len(tournaments) != 5 {
+		t.Fatalf("Expected 5 tournaments, got %d", len(tournaments))
+	}
+
+	for i := 1; i < len(tournaments); i++ {
+		if tournaments[i-1].ID >= tournaments[i].ID {
+			t.Errorf("Expected tournaments sorted by ID, got %d before %d", tournaments[i-1].ID, tournaments[i].ID)
+		}
+	}
+}
+
 func TestMockClient_Logout(t *testing.T) {
 	mockClient := NewMockClient("testuser", "testpass")
 
 	// Login first
-	err := mockClient.Login()
+	err := mockClient.Login(context.Background())
 	if err != nil {
 		t.Fatalf("Failed to login: %v", err)
 	}
@@ -405,12 +441,12 @@ func TestMockClient_Reset(t *testing.T) {
 	mockClient := NewMockClient("testuser", "testpass")
 
 	// Login and create tournament
-	err := mockClient.Login()
+	err := mockClient.Login(context.Background())
 	if err != nil {
 		t.Fatalf("Failed to login: %v", err)
 	}
 
-	_, err = mockClient.CreateSwissTournament("Elite", "player1", "player2", 1, 15)
+	_, err = mockClient.CreateSwissTournament(context.Background(), "Elite", "player1", "player2", 1, 15)
 	if err != nil {
 		t.Fatalf("Failed to create tournament: %v", err)
 	}
@@ -443,7 +479,7 @@ func TestMockClient_Reset(t *testing.T) {
 
 func TestMockClient_CreateSwissTournamentWithDateTime(t *testing.T) {
 	mockClient := NewMockClient("testuser", "testpass")
-	err := mockClient.Login()
+	err := mockClient.Login(context.Background())
 	if err != nil {
 		t.Fatalf("Failed to login: %v", err)
 	}
@@ -451,6 +487,7 @@ func TestMockClient_CreateSwissTournamentWithDateTime(t *testing.T) {
 	// Create tournament with specific datetime
 	scheduledTime := time.Date(2025, 3, 15, 14, 30, 0, 0, time.Local)
 	resp, err := mockClient.CreateSwissTournamentWithDateTime(
+		context.Background(),
 		"Elite",
 		"herchu",
 		"Lord Trooper",
@@ -475,7 +512,7 @@ func TestMockClient_CreateSwissTournamentWithDateTime(t *testing.T) {
 	}
 
 	// Verify tournament was created with correct name
-	status, err := mockClient.GetTournamentStatus(resp.TournamentID)
+	status, err := mockClient.GetTournamentStatus(context.Background(), resp.TournamentID)
 	if err != nil {
 		t.Fatalf("Failed to get tournament status: %v", err)
 	}
@@ -488,7 +525,7 @@ func TestMockClient_CreateSwissTournamentWithDateTime(t *testing.T) {
 
 func TestTournamentNamingConvention(t *testing.T) {
 	mockClient := NewMockClient("testuser", "testpass")
-	err := mockClient.Login()
+	err := mockClient.Login(context.Background())
 	if err != nil {
 		t.Fatalf("Failed to login: %v", err)
 	}
@@ -538,6 +575,7 @@ func TestTournamentNamingConvention(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			resp, err := mockClient.CreateSwissTournament(
+				context.Background(),
 				tc.division,
 				tc.homePlayer,
 				tc.awayPlayer,
@@ -553,7 +591,7 @@ func TestTournamentNamingConvention(t *testing.T) {
 			}
 
 			// Get tournament status to verify naming
-			status, err := mockClient.GetTournamentStatus(resp.TournamentID)
+			status, err := mockClient.GetTournamentStatus(context.Background(), resp.TournamentID)
 			if err != nil {
 				t.Fatalf("Failed to get tournament status: %v", err)
 			}
@@ -574,13 +612,13 @@ func TestTournamentNamingConvention(t *testing.T) {
 
 func TestMockClient_ThreeStepTournamentCreation(t *testing.T) {
 	mockClient := NewMockClient("testuser", "testpass")
-	err := mockClient.Login()
+	err := mockClient.Login(context.Background())
 	if err != nil {
 		t.Fatalf("Failed to login: %v", err)
 	}
 
 	// Step 1: Create tournament
-	resp, err := mockClient.CreateSwissTournament("Elite", "herchu", "Lord Trooper", 1, 15)
+	resp, err := mockClient.CreateSwissTournament(context.Background(), "Elite", "herchu", "Lord Trooper", 1, 15)
 	if err != nil {
 		t.Fatalf("Failed to create tournament: %v", err)
 	}
@@ -590,24 +628,24 @@ func TestMockClient_ThreeStepTournamentCreation(t *testing.T) {
 	}
 
 	// Step 2: Launch tournament
-	err = mockClient.LaunchTournament(resp.TournamentID)
+	err = mockClient.LaunchTournament(context.Background(), resp.TournamentID)
 	if err != nil {
 		t.Fatalf("Failed to launch tournament: %v", err)
 	}
 
 	// Step 3: Invite players (using placeholder player IDs for now)
-	err = mockClient.InvitePlayer(resp.TournamentID, "herchu_player_id")
+	err = mockClient.InvitePlayer(context.Background(), resp.TournamentID, 84000001)
 	if err != nil {
 		t.Fatalf("Failed to invite first player: %v", err)
 	}
 
-	err = mockClient.InvitePlayer(resp.TournamentID, "lord_trooper_player_id")
+	err = mockClient.InvitePlayer(context.Background(), resp.TournamentID, 84000002)
 	if err != nil {
 		t.Fatalf("Failed to invite second player: %v", err)
 	}
 
 	// Verify tournament is in launched state
-	status, err := mockClient.GetTournamentStatus(resp.TournamentID)
+	status, err := mockClient.GetTournamentStatus(context.Background(), resp.TournamentID)
 	if err != nil {
 		t.Fatalf("Failed to get tournament status: %v", err)
 	}
@@ -625,7 +663,7 @@ func TestMockClient_CompleteThreeStepWorkflowDemo(t *testing.T) {
 
 	// Authentication
 	t.Log("Step 0: Authenticating...")
-	err := mockClient.Login()
+	err := mockClient.Login(context.Background())
 	if err != nil {
 		t.Fatalf("Failed to authenticate: %v", err)
 	}
@@ -639,7 +677,7 @@ func TestMockClient_CompleteThreeStepWorkflowDemo(t *testing.T) {
 	roundNum := 1
 	matchNum := 15
 
-	resp, err := mockClient.CreateSwissTournament(division, homePlayer, awayPlayer, roundNum, matchNum)
+	resp, err := mockClient.CreateSwissTournament(context.Background(), division, homePlayer, awayPlayer, roundNum, matchNum)
 	if err != nil {
 		t.Fatalf("Failed to create tournament: %v", err)
 	}
@@ -649,7 +687,7 @@ func TestMockClient_CompleteThreeStepWorkflowDemo(t *testing.T) {
 	t.Logf("  - Tournament Link: %s", resp.Link)
 
 	// Verify initial tournament state
-	status, err := mockClient.GetTournamentStatus(resp.TournamentID)
+	status, err := mockClient.GetTournamentStatus(context.Background(), resp.TournamentID)
 	if err != nil {
 		t.Fatalf("Failed to get tournament status: %v", err)
 	}
@@ -657,14 +695,14 @@ func TestMockClient_CompleteThreeStepWorkflowDemo(t *testing.T) {
 
 	// Step 2: Launch Tournament
 	t.Log("Step 2: Launching tournament...")
-	err = mockClient.LaunchTournament(resp.TournamentID)
+	err = mockClient.LaunchTournament(context.Background(), resp.TournamentID)
 	if err != nil {
 		t.Fatalf("Failed to launch tournament: %v", err)
 	}
 	t.Log("✓ Tournament launched successfully")
 
 	// Verify tournament is now open
-	status, err = mockClient.GetTournamentStatus(resp.TournamentID)
+	status, err = mockClient.GetTournamentStatus(context.Background(), resp.TournamentID)
 	if err != nil {
 		t.Fatalf("Failed to get tournament status after launch: %v", err)
 	}
@@ -676,20 +714,20 @@ func TestMockClient_CompleteThreeStepWorkflowDemo(t *testing.T) {
 
 	// Step 3: Invite Players
 	t.Log("Step 3: Inviting players...")
-	playerIDs := []string{"herchu_bga_id", "lord_trooper_bga_id"}
+	playerIDs := []int{84000001, 84000002}
 
 	for i, playerID := range playerIDs {
-		t.Logf("  Inviting player %d: %s", i+1, playerID)
-		err = mockClient.InvitePlayer(resp.TournamentID, playerID)
+		t.Logf("  Inviting player %d: %d", i+1, playerID)
+		err = mockClient.InvitePlayer(context.Background(), resp.TournamentID, playerID)
 		if err != nil {
-			t.Fatalf("Failed to invite player %s: %v", playerID, err)
+			t.Fatalf("Failed to invite player %d: %v", playerID, err)
 		}
 	}
 	t.Log("✓ All players invited successfully")
 
 	// Final verification
 	t.Log("Final verification...")
-	finalStatus, err := mockClient.GetTournamentStatus(resp.TournamentID)
+	finalStatus, err := mockClient.GetTournamentStatus(context.Background(), resp.TournamentID)
 	if err != nil {
 		t.Fatalf("Failed to get final tournament status: %v", err)
 	}
@@ -708,3 +746,1217 @@ func TestMockClient_CompleteThreeStepWorkflowDemo(t *testing.T) {
 
 	t.Log("=== Three-Step Tournament Creation Demo Complete ===")
 }
+
+// flakyTransport fails the first failures requests and then delegates to respond
+type flakyTransport struct {
+	respond  func(req *http.Request) *http.Response
+	failures int
+	calls    int
+}
+
+func (f *flakyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	f.calls++
+	if f.calls <= f.failures {
+		return nil, errors.New("connection reset by peer")
+	}
+
+	return f.respond(req), nil
+}
+
+func stubResponse(req *http.Request, status int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: status,
+		Header:     make(http.Header),
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}
+}
+
+func TestClient_CreateTournament_RetriesTransientFailures(t *testing.T) {
+	transport := &flakyTransport{
+		failures: 2,
+		respond: func(req *http.Request) *http.Response {
+			return stubResponse(req, http.StatusOK, `{"success":true,"tournament_id":424242}`)
+		},
+	}
+
+	client := NewClient("user", "pass",
+		WithRetry(RetryConfig{MaxAttempts: 3, BaseDelay: time.Millisecond}), WithRateLimit(0))
+	client.httpClient.Transport = transport
+	if err := client.setSessionCookie("test-session-id"); err != nil {
+		t.Fatalf("Failed to set session cookie: %v", err)
+	}
+
+	resp, err := client.CreateSwissTournament(context.Background(), "Elite", "herchu", "Lord Trooper", 5, 17)
+	if err != nil {
+		t.Fatalf("Expected tournament to be created after retries, got error: %v", err)
+	}
+
+	if !resp.Success || resp.TournamentID != 424242 {
+		t.Errorf("Expected successful response with ID 424242, got %+v", resp)
+	}
+
+	if transport.calls != 3 {
+		t.Errorf("Expected 3 attempts, got %d", transport.calls)
+	}
+}
+
+func TestClient_Login_RetriesServerErrors(t *testing.T) {
+	calls := 0
+	transport := &flakyTransport{
+		respond: func(req *http.Request) *http.Response {
+			calls++
+			if calls < 3 {
+				return stubResponse(req, http.StatusServiceUnavailable, "busy")
+			}
+
+			resp := stubResponse(req, http.StatusOK, "")
+			resp.Header.Add("Set-Cookie", "PHPSESSID=abc123; Path=/")
+
+			return resp
+		},
+	}
+
+	client := NewClient("user", "pass",
+		WithRetry(RetryConfig{MaxAttempts: 3, BaseDelay: time.Millisecond}), WithRateLimit(0))
+	client.httpClient.Transport = transport
+
+	if err := client.Login(context.Background()); err != nil {
+		t.Fatalf("Expected login to succeed after retries, got: %v", err)
+	}
+
+	if !client.IsAuthenticated() {
+		t.Error("Expected client to be authenticated")
+	}
+}
+
+func TestClient_CreateTournament_DoesNotRetryValidationFailures(t *testing.T) {
+	transport := &flakyTransport{
+		respond: func(req *http.Request) *http.Response {
+			return stubResponse(req, http.StatusBadRequest, "invalid tournament name")
+		},
+	}
+
+	client := NewClient("user", "pass", WithRetry(RetryConfig{MaxAttempts: 3, BaseDelay: time.Millisecond}))
+	client.httpClient.Transport = transport
+	if err := client.setSessionCookie("test-session-id"); err != nil {
+		t.Fatalf("Failed to set session cookie: %v", err)
+	}
+
+	_, err := client.CreateSwissTournament(context.Background(), "Elite", "herchu", "Lord Trooper", 5, 17)
+	if err == nil {
+		t.Fatal("Expected error for a 400 response")
+	}
+
+	if transport.calls != 1 {
+		t.Errorf("Expected a single attempt for a validation failure, got %d", transport.calls)
+	}
+}
+
+func TestRetryConfig_Backoff(t *testing.T) {
+	config := RetryConfig{MaxAttempts: 5, BaseDelay: 100 * time.Millisecond, MaxDelay: 300 * time.Millisecond}
+
+	expected := []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 300 * time.Millisecond}
+	for i, want := range expected {
+		if got := config.backoff(i + 1); got != want {
+			t.Errorf("Attempt %d: expected delay %v, got %v", i+1, want, got)
+		}
+	}
+}
+
+func TestMockClient_CreateTournament_HonorsCancellation(t *testing.T) {
+	mockClient := NewMockClient("testuser", "testpass")
+	mockClient.SetLatency(200 * time.Millisecond)
+	if err := mockClient.Login(context.Background()); err != nil {
+		t.Fatalf("Failed to login: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	start := time.Now()
+	_, err := mockClient.CreateSwissTournament(ctx, "Elite", "herchu", "Lord Trooper", 1, 15)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected context.Canceled, got %v", err)
+	}
+
+	if elapsed := time.Since(start); elapsed >= 200*time.Millisecond {
+		t.Errorf("Expected creation to stop before the simulated delay, took %v", elapsed)
+	}
+
+	if len(mockClient.GetTournaments()) != 0 {
+		t.Error("Expected no tournament to be recorded after cancellation")
+	}
+}
+
+func TestMockClient_SetTransientFailures(t *testing.T) {
+	mockClient := NewMockClient("testuser", "testpass")
+	if err := mockClient.Login(context.Background()); err != nil {
+		t.Fatalf("Failed to login: %v", err)
+	}
+	mockClient.SetTransientFailures(2)
+
+	for attempt := 1; attempt <= 2; attempt++ {
+		_, err := mockClient.CreateSwissTournament(context.Background(), "Elite", "p1", "p2", 1, 1)
+		if !errors.Is(err, ErrTransient) {
+			t.Fatalf("Attempt %d: expected ErrTransient, got %v", attempt, err)
+		}
+	}
+
+	resp, err := mockClient.CreateSwissTournament(context.Background(), "Elite", "p1", "p2", 1, 1)
+	if err != nil {
+		t.Fatalf("Expected third attempt to succeed, got %v", err)
+	}
+
+	if !resp.Success {
+		t.Error("Expected third attempt to return a successful response")
+	}
+
+	if len(mockClient.GetTournaments()) != 1 {
+		t.Errorf("Expected only the successful attempt to be recorded, got %d", len(mockClient.GetTournaments()))
+	}
+}
+
+func TestMockClient_SetLatency(t *testing.T) {
+	mockClient := NewMockClient("testuser", "testpass")
+	mockClient.SetLatency(30 * time.Millisecond)
+
+	start := time.Now()
+	if err := mockClient.Login(context.Background()); err != nil {
+		t.Fatalf("Failed to login: %v", err)
+	}
+
+	if elapsed := time.Since(start); elapsed < 30*time.Millisecond {
+		t.Errorf("Expected login to take at least the configured latency, took %v", elapsed)
+	}
+}
+
+func TestMockClient_Login_HonorsCancellation(t *testing.T) {
+	mockClient := NewMockClient("testuser", "testpass")
+	mockClient.SetLatency(100 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := mockClient.Login(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected context.DeadlineExceeded, got %v", err)
+	}
+
+	if mockClient.IsAuthenticated() {
+		t.Error("Expected client to remain unauthenticated after a canceled login")
+	}
+}
+
+func TestClient_CreateTournament_StopsRetryingWhenCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	transport := &flakyTransport{failures: 3}
+	transport.respond = func(req *http.Request) *http.Response {
+		return stubResponse(req, http.StatusOK, `{"success":true,"tournament_id":1}`)
+	}
+
+	client := NewClient("user", "pass", WithRetry(RetryConfig{MaxAttempts: 3, BaseDelay: time.Hour}))
+	client.httpClient.Transport = transport
+	if err := client.setSessionCookie("test-session-id"); err != nil {
+		t.Fatalf("Failed to set session cookie: %v", err)
+	}
+
+	time.AfterFunc(10*time.Millisecond, cancel)
+
+	_, err := client.CreateSwissTournament(ctx, "Elite", "herchu", "Lord Trooper", 5, 17)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected context.Canceled, got %v", err)
+	}
+
+	if transport.calls != 1 {
+		t.Errorf("Expected a single attempt before cancellation, got %d", transport.calls)
+	}
+}
+
+func TestClient_ReplaysAllLoginCookies(t *testing.T) {
+	var createCookies []*http.Cookie
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/account/account/login.html", func(w http.ResponseWriter, _ *http.Request) {
+		http.SetCookie(w, &http.Cookie{Name: "PHPSESSID", Value: "abc123", Path: "/"})
+		http.SetCookie(w, &http.Cookie{Name: "TournoiEnLigneidt", Value: "xyz789", Path: "/"})
+	})
+	mux.HandleFunc("/newtournament/newtournament/create.html", func(w http.ResponseWriter, r *http.Request) {
+		createCookies = r.Cookies()
+		_, _ = io.WriteString(w, `{"success":true,"tournament_id":424242}`)
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client := NewClient("user", "pass", WithRateLimit(0))
+	client.baseURL = server.URL
+
+	if err := client.Login(context.Background()); err != nil {
+		t.Fatalf("Login failed: %v", err)
+	}
+
+	if !client.IsAuthenticated() {
+		t.Fatal("Expected client to be authenticated after login")
+	}
+
+	if _, err := client.CreateSwissTournament(context.Background(), "Elite", "herchu", "webbi", 1, 1); err != nil {
+		t.Fatalf("CreateSwissTournament failed: %v", err)
+	}
+
+	got := make(map[string]string)
+	for _, cookie := range createCookies {
+		got[cookie.Name] = cookie.Value
+	}
+
+	if got["PHPSESSID"] != "abc123" || got["TournoiEnLigneidt"] != "xyz789" {
+		t.Errorf("Expected both login cookies on the create request, got %v", got)
+	}
+
+	if len(createCookies) != 2 {
+		t.Errorf("Expected exactly 2 cookies, got %d", len(createCookies))
+	}
+}
+
+func TestClient_Logout_ClearsCookies(t *testing.T) {
+	transport := &flakyTransport{
+		respond: func(req *http.Request) *http.Response {
+			return stubResponse(req, http.StatusOK, "")
+		},
+	}
+
+	client := NewClient("user", "pass")
+	client.httpClient.Transport = transport
+	if err := client.setSessionCookie("abc123"); err != nil {
+		t.Fatalf("Failed to set session cookie: %v", err)
+	}
+
+	if err := client.Logout(); err != nil {
+		t.Fatalf("Logout failed: %v", err)
+	}
+
+	if client.IsAuthenticated() {
+		t.Error("Expected client to be unauthenticated after logout")
+	}
+}
+
+func TestClient_LaunchTournament(t *testing.T) {
+	var gotMethod, gotID string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/tournament/tournament/launchTournament.html" {
+			http.NotFound(w, r)
+			return
+		}
+		gotMethod = r.Method
+		gotID = r.FormValue("id")
+		_, _ = io.WriteString(w, `{"status":1,"data":{"success":true}}`)
+	}))
+	defer server.Close()
+
+	client := NewClient("user", "pass")
+	client.baseURL = server.URL
+	if err := client.setSessionCookie("test-session-id"); err != nil {
+		t.Fatalf("Failed to set session cookie: %v", err)
+	}
+
+	if err := client.LaunchTournament(context.Background(), 424242); err != nil {
+		t.Fatalf("Expected launch to succeed, got: %v", err)
+	}
+
+	if gotMethod != http.MethodPost {
+		t.Errorf("Expected POST, got %s", gotMethod)
+	}
+	if gotID != "424242" {
+		t.Errorf("Expected tournament id 424242, got %q", gotID)
+	}
+}
+
+func TestClient_LaunchTournament_NotLaunchable(t *testing.T) {
+	transport := &flakyTransport{
+		respond: func(req *http.Request) *http.Response {
+			return stubResponse(req, http.StatusOK, `{"status":"0","error":"This tournament has already started","code":100}`)
+		},
+	}
+
+	client := NewClient("user", "pass")
+	client.httpClient.Transport = transport
+	if err := client.setSessionCookie("test-session-id"); err != nil {
+		t.Fatalf("Failed to set session cookie: %v", err)
+	}
+
+	err := client.LaunchTournament(context.Background(), 424242)
+	if err == nil {
+		t.Fatal("Expected error for a tournament that cannot be launched")
+	}
+
+	if !strings.Contains(err.Error(), "424242") || !strings.Contains(err.Error(), "already started") {
+		t.Errorf("Expected descriptive error, got: %v", err)
+	}
+}
+
+func TestClient_LaunchTournament_RequiresAuthentication(t *testing.T) {
+	client := NewClient("user", "pass")
+	if err := client.LaunchTournament(context.Background(), 424242); err == nil {
+		t.Fatal("Expected error when launching without a session")
+	}
+}
+
+func TestMockClient_FailNextCreates(t *testing.T) {
+	mockClient := NewMockClient("testuser", "testpass")
+	if err := mockClient.Login(context.Background()); err != nil {
+		t.Fatalf("Failed to login: %v", err)
+	}
+
+	mockClient.FailNextCreates(1)
+
+	resp, err := mockClient.CreateSwissTournament(context.Background(), "Elite", "herchu", "webbi", 1, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if resp.Success {
+		t.Error("Expected first creation to fail")
+	}
+
+	resp, err = mockClient.CreateSwissTournament(context.Background(), "Elite", "herchu", "webbi", 1, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !resp.Success {
+		t.Errorf("Expected second creation to succeed, got %s", resp.Error)
+	}
+}
+
+func TestClient_InvitePlayer(t *testing.T) {
+	var gotPath, gotID, gotPlayer string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotID = r.FormValue("id")
+		gotPlayer = r.FormValue("player")
+		_, _ = io.WriteString(w, `{"status":1,"data":null}`)
+	}))
+	defer server.Close()
+
+	client := NewClient("user", "pass")
+	client.baseURL = server.URL
+	if err := client.setSessionCookie("test-session-id"); err != nil {
+		t.Fatalf("Failed to set session cookie: %v", err)
+	}
+
+	if err := client.InvitePlayer(context.Background(), 424242, 84123456); err != nil {
+		t.Fatalf("Expected invite to succeed, got: %v", err)
+	}
+
+	if gotPath != "/tournament/tournament/addPlayerToTournament.html" {
+		t.Errorf("Unexpected endpoint %q", gotPath)
+	}
+	if gotID != "424242" || gotPlayer != "84123456" {
+		t.Errorf("Expected id=424242 player=84123456, got id=%q player=%q", gotID, gotPlayer)
+	}
+}
+
+func TestClient_InvitePlayer_Rejected(t *testing.T) {
+	transport := &flakyTransport{
+		respond: func(req *http.Request) *http.Response {
+			return stubResponse(req, http.StatusOK, `{"status":"0","error":"Player is already registered"}`)
+		},
+	}
+
+	client := NewClient("user", "pass")
+	client.httpClient.Transport = transport
+	if err := client.setSessionCookie("test-session-id"); err != nil {
+		t.Fatalf("Failed to set session cookie: %v", err)
+	}
+
+	err := client.InvitePlayer(context.Background(), 424242, 84123456)
+	if err == nil || !strings.Contains(err.Error(), "already registered") {
+		t.Errorf("Expected rejection error, got: %v", err)
+	}
+}
+
+func TestClient_ResolvePlayerID(t *testing.T) {
+	var queries []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.Query().Get("q"))
+		_, _ = io.WriteString(w, `{"status":1,"data":{"items":[`+
+			`{"id":84000001,"fullname":"herchu2"},`+
+			`{"id":84000002,"fullname":"Herchu"},`+
+			`{"id":84000003,"fullname":"Lord Trooper"},`+
+			`{"id":84000004,"fullname":"lord trooper"}]}}`)
+	}))
+	defer server.Close()
+
+	client := NewClient("user", "pass", WithRateLimit(0))
+	client.baseURL = server.URL
+	if err := client.setSessionCookie("test-session-id"); err != nil {
+		t.Fatalf("Failed to set session cookie: %v", err)
+	}
+
+	id, err := client.ResolvePlayerID(context.Background(), "herchu")
+	if err != nil {
+		t.Fatalf("Expected player to be resolved, got: %v", err)
+	}
+
+	if id != 84000002 {
+		t.Errorf("Expected exact username match 84000002, got %d", id)
+	}
+
+	if _, err := client.ResolvePlayerID(context.Background(), "webbi"); err == nil {
+		t.Error("Expected error for a username without an exact match")
+	}
+
+	if _, err := client.ResolvePlayerID(context.Background(), "Lord Trooper"); err == nil ||
+		!strings.Contains(err.Error(), "ambiguous") {
+		t.Errorf("Expected ambiguity error for multiple exact matches, got: %v", err)
+	}
+
+	// A repeated opponent is served from the cache
+	if _, err := client.ResolvePlayerID(context.Background(), "herchu"); err != nil {
+		t.Fatalf("Unexpected error on cached lookup: %v", err)
+	}
+
+	if len(queries) != 3 || queries[0] != "herchu" {
+		t.Errorf("Expected 3 lookups starting with 'herchu', got %v", queries)
+	}
+}
+
+func TestClient_ResolvePlayerID_Concurrent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"status":1,"data":{"items":[{"id":84000001,"fullname":%q}]}}`, r.URL.Query().Get("q"))
+	}))
+	defer server.Close()
+
+	client := NewClient("user", "pass", WithRateLimit(0))
+	client.baseURL = server.URL
+	if err := client.setSessionCookie("test-session-id"); err != nil {
+		t.Fatalf("Failed to set session cookie: %v", err)
+	}
+
+	// Invitations resolve both players from commands running at the same time
+	var wg sync.WaitGroup
+	for _, username := range []string{"herchu", "webbi", "herchu", "webbi"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.ResolvePlayerID(context.Background(), username); err != nil {
+				t.Errorf("Unexpected error resolving %s: %v", username, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if id, ok := client.cachedPlayerID("webbi"); !ok || id != 84000001 {
+		t.Errorf("Expected webbi cached as 84000001, got %d (cached %v)", id, ok)
+	}
+}
+
+func TestMockClient_ResolvePlayerID(t *testing.T) {
+	mockClient := NewMockClient("testuser", "testpass")
+	if _, err := mockClient.ResolvePlayerID(context.Background(), "herchu"); err == nil {
+		t.Error("Expected error before login")
+	}
+
+	if err := mockClient.Login(context.Background()); err != nil {
+		t.Fatalf("Failed to login: %v", err)
+	}
+
+	mockClient.SetPlayerIDs(map[string]int{"Lord Trooper": 91234567})
+
+	seeded, err := mockClient.ResolvePlayerID(context.Background(), "Lord Trooper")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if seeded != 91234567 {
+		t.Errorf("Expected seeded ID 91234567, got %d", seeded)
+	}
+
+	first, err := mockClient.ResolvePlayerID(context.Background(), "herchu")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	again, _ := mockClient.ResolvePlayerID(context.Background(), "herchu")
+	other, _ := mockClient.ResolvePlayerID(context.Background(), "webbi")
+
+	if first != again {
+		t.Errorf("Expected stable ID for the same username, got %d and %d", first, again)
+	}
+	if first == other {
+		t.Error("Expected different usernames to get different IDs")
+	}
+}
+
+func TestMockClient_ReportMatchResult_FinishesTournament(t *testing.T) {
+	mockClient := NewMockClient("testuser", "testpass")
+	if err := mockClient.Login(context.Background()); err != nil {
+		t.Fatalf("Failed to login: %v", err)
+	}
+
+	resp, err := mockClient.CreateSwissTournament(context.Background(), "Elite", "herchu", "webbi", 1, 1)
+	if err != nil {
+		t.Fatalf("Failed to create tournament: %v", err)
+	}
+
+	results := []struct{ table, home, away int }{{1, 120, 95}, {2, 88, 101}, {3, 110, 104}}
+	for i, result := range results {
+		if err := mockClient.ReportMatchResult(
+			context.Background(), resp.TournamentID, result.table, result.home, result.away,
+		); err != nil {
+			t.Fatalf("Failed to report table %d: %v", result.table, err)
+		}
+
+		status, err := mockClient.GetTournamentStatus(context.Background(), resp.TournamentID)
+		if err != nil {
+			t.Fatalf("Failed to get status: %v", err)
+		}
+
+		expected := "in_progress"
+		if i == len(results)-1 {
+			expected = "finished"
+		}
+		if status.Status != expected {
+			t.Errorf("After table %d expected status %q, got %q", result.table, expected, status.Status)
+		}
+	}
+
+	status, _ := mockClient.GetTournamentStatus(context.Background(), resp.TournamentID)
+	if status.Results["herchu"] != 2 || status.Results["webbi"] != 1 {
+		t.Errorf("Expected herchu 2 - webbi 1, got %v", status.Results)
+	}
+
+	if err := mockClient.ReportMatchResult(context.Background(), resp.TournamentID, 1, 100, 90); err == nil {
+		t.Error("Expected error when reporting a table twice")
+	}
+	if err := mockClient.ReportMatchResult(context.Background(), resp.TournamentID, 9, 100, 90); err == nil {
+		t.Error("Expected error for an unknown game table")
+	}
+}
+
+func TestClient_ReportMatchResult(t *testing.T) {
+	var form map[string]string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/tournament/tournament/reportResult.html" {
+			http.NotFound(w, r)
+			return
+		}
+		_ = r.ParseForm()
+		form = map[string]string{
+			"id":     r.FormValue("id"),
+			"table":  r.FormValue("table"),
+			"score1": r.FormValue("score1"),
+			"score2": r.FormValue("score2"),
+		}
+		_, _ = io.WriteString(w, `{"status":1}`)
+	}))
+	defer server.Close()
+
+	client := NewClient("user", "pass")
+	client.baseURL = server.URL
+	if err := client.setSessionCookie("test-session-id"); err != nil {
+		t.Fatalf("Failed to set session cookie: %v", err)
+	}
+
+	if err := client.ReportMatchResult(context.Background(), 424242, 7, 120, 95); err != nil {
+		t.Fatalf("Expected report to succeed, got: %v", err)
+	}
+
+	expected := map[string]string{"id": "424242", "table": "7", "score1": "120", "score2": "95"}
+	for key, want := range expected {
+		if form[key] != want {
+			t.Errorf("Expected %s=%s, got %q", key, want, form[key])
+		}
+	}
+}
+
+func TestClient_CreateSwissTournament_BestOfFive(t *testing.T) {
+	var form map[string]string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		form = map[string]string{
+			"matches":  r.PostForm.Get("mode_option_swissSystemV2_103"),
+			"duration": r.PostForm.Get("game_max_duration"),
+		}
+		_, _ = io.WriteString(w, `{"success":true,"tournament_id":424242}`)
+	}))
+	defer server.Close()
+
+	client := NewClient("user", "pass")
+	client.baseURL = server.URL
+	if err := client.setSessionCookie("test-session-id"); err != nil {
+		t.Fatalf("Failed to set session cookie: %v", err)
+	}
+
+	_, err := client.CreateSwissTournament(
+		context.Background(), "Elite", "herchu", "webbi", 1, 1,
+		WithMatchCount(5), WithGameDuration(1200),
+	)
+	if err != nil {
+		t.Fatalf("CreateSwissTournament failed: %v", err)
+	}
+
+	if form["matches"] != "5" {
+		t.Errorf("Expected mode_option_swissSystemV2_103=5, got %q", form["matches"])
+	}
+	if form["duration"] != "1200" {
+		t.Errorf("Expected game_max_duration=1200, got %q", form["duration"])
+	}
+}
+
+func TestNewSwissTournamentConfig_Defaults(t *testing.T) {
+	config := newSwissTournamentConfig("Elite", "herchu", "webbi", 2, 9, "2025-09-01", "21:15")
+
+	if config.MatchesCount != DefaultMatchCount || config.GameDuration != DefaultGameDuration {
+		t.Errorf("Expected best-of-%d with %ds games, got %d games of %ds",
+			DefaultMatchCount, DefaultGameDuration, config.MatchesCount, config.GameDuration)
+	}
+
+	if config.TournamentName != "2 Fecha - Duelo 9 - herchu vs webbi" {
+		t.Errorf("Unexpected tournament name %q", config.TournamentName)
+	}
+	if config.ChampionshipName != "Division Elite - 1era Temporada" {
+		t.Errorf("Unexpected championship name %q", config.ChampionshipName)
+	}
+}
+
+func TestMockClient_CreateSwissTournament_BestOfFive(t *testing.T) {
+	mockClient := NewMockClient("testuser", "testpass")
+	if err := mockClient.Login(context.Background()); err != nil {
+		t.Fatalf("Failed to login: %v", err)
+	}
+
+	resp, err := mockClient.CreateSwissTournament(context.Background(), "Elite", "herchu", "webbi", 1, 1, WithMatchCount(5))
+	if err != nil {
+		t.Fatalf("Failed to create tournament: %v", err)
+	}
+
+	status, err := mockClient.GetTournamentStatus(context.Background(), resp.TournamentID)
+	if err != nil {
+		t.Fatalf("Failed to get status: %v", err)
+	}
+
+	if len(status.Matches) != 5 {
+		t.Errorf("Expected 5 game tables, got %d", len(status.Matches))
+	}
+}
+
+func TestClient_SetCarcassonneGameOptions_Expansions(t *testing.T) {
+	testCases := []struct {
+		name       string
+		expansions []Expansion
+		enabled    []string
+	}{
+		{"none by default", nil, nil},
+		{"river", []Expansion{ExpansionRiver}, []string{"gameoption_201"}},
+		{"inns and cathedrals", []Expansion{ExpansionInnsCathedrals}, []string{"gameoption_206"}},
+		{
+			"both",
+			[]Expansion{ExpansionRiver, ExpansionInnsCathedrals},
+			[]string{"gameoption_201", "gameoption_206"},
+		},
+	}
+
+	client := NewClient("user", "pass")
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := newSwissTournamentConfig(
+				"Elite", "herchu", "webbi", 1, 1, "2025-09-01", "21:00", WithExpansions(tc.expansions...),
+			)
+			form := client.buildTournamentForm(config)
+
+			enabled := make(map[string]bool)
+			for _, key := range tc.enabled {
+				enabled[key] = true
+			}
+
+			for _, key := range []string{"gameoption_201", "gameoption_206"} {
+				want := "0"
+				if enabled[key] {
+					want = "1"
+				}
+				if got := form.Get(key); got != want {
+					t.Errorf("Expected %s=%s, got %q", key, want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestFormOptionSummary(t *testing.T) {
+	summary := FormOptionSummary(WithMatchCount(5))
+
+	if len(summary) == 0 || summary[0] != "stage_type=swissSystemV2" {
+		t.Fatalf("Expected the stage type first, got %v", summary)
+	}
+
+	for _, want := range []string{"mode_option_swissSystemV2_103=5", "gameoption_200=5"} {
+		if !slices.Contains(summary, want) {
+			t.Errorf("Expected %q in the summary, got %v", want, summary)
+		}
+	}
+
+	for _, option := range summary {
+		if strings.HasPrefix(option, "stage_1_") || strings.HasPrefix(option, "registration_") {
+			t.Errorf("Expected only stage type, game and mode options, got %q", option)
+		}
+	}
+}
+
+func TestBuildTournamentForm_StageTypes(t *testing.T) {
+	client := NewClient("user", "pass")
+
+	swiss := client.buildTournamentForm(
+		newSwissTournamentConfig("Elite", "herchu", "webbi", 1, 1, "2025-09-01", "21:00"),
+	)
+	knockout := client.buildTournamentForm(
+		newSwissTournamentConfig(
+			"Elite", "herchu", "webbi", 1, 1, "2025-09-01", "21:00", WithStageType(StageSingleElimination),
+		),
+	)
+
+	if got := swiss.Get("stage_type"); got != "swissSystemV2" {
+		t.Errorf("Expected Swiss to be the default stage_type, got %q", got)
+	}
+
+	if got := knockout.Get("stage_type"); got != "simple" {
+		t.Errorf("Expected single elimination stage_type=simple, got %q", got)
+	}
+
+	if got := knockout.Get("mode_option_simple_100"); got != "3" {
+		t.Errorf("Expected single elimination to carry the games per duel, got %q", got)
+	}
+
+	if swiss.Has("mode_option_simple_100") {
+		t.Error("Expected Swiss form to omit single elimination options")
+	}
+
+	if knockout.Has("mode_option_swissSystemV2_103") {
+		t.Error("Expected single elimination form to omit Swiss options")
+	}
+}
+
+func TestClient_CreateKnockoutTournament(t *testing.T) {
+	client := NewClient("user", "pass", WithDryRun())
+	if err := client.setSessionCookie("test-session-id"); err != nil {
+		t.Fatalf("Failed to set session cookie: %v", err)
+	}
+
+	scheduled := time.Date(2025, 9, 20, 18, 30, 0, 0, time.UTC)
+	resp, err := client.CreateKnockoutTournament(
+		context.Background(), "Elite", "Copa Elite", 8, scheduled, WithMatchCount(5),
+	)
+	if err != nil {
+		t.Fatalf("Knockout creation failed: %v", err)
+	}
+
+	if !resp.Success {
+		t.Fatalf("Expected a successful response, got %+v", resp)
+	}
+
+	form := client.LastFormData()
+	expected := map[string]string{
+		"stage_type":             "simple",
+		"tournament_name":        "Copa Elite",
+		"max_players":            "8",
+		"base_date":              "2025-09-20",
+		"base_date_hour":         "18:30",
+		"mode_option_simple_100": "5",
+	}
+	for key, want := range expected {
+		if got := form.Get(key); got != want {
+			t.Errorf("Expected %s=%s, got %q", key, want, got)
+		}
+	}
+}
+
+func TestClient_GetTournamentStatus(t *testing.T) {
+	fixture, err := os.ReadFile("testdata/tournament_status.json")
+	if err != nil {
+		t.Fatalf("Failed to read fixture: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("id") != "423762" {
+			t.Errorf("Expected status request for tournament 423762, got %s", r.URL.RawQuery)
+		}
+		_, _ = w.Write(fixture)
+	}))
+	defer server.Close()
+
+	client := NewClient("user", "pass")
+	client.baseURL = server.URL
+	if err := client.setSessionCookie("test-session-id"); err != nil {
+		t.Fatalf("Failed to set session cookie: %v", err)
+	}
+
+	status, err := client.GetTournamentStatus(context.Background(), 423762)
+	if err != nil {
+		t.Fatalf("Expected status to parse, got: %v", err)
+	}
+
+	if status.Name != "1 Fecha - Duelo 15 - herchu vs Lord Trooper" {
+		t.Errorf("Unexpected tournament name: %s", status.Name)
+	}
+
+	if status.Status != "in_progress" {
+		t.Errorf("Expected status 'in_progress', got %s", status.Status)
+	}
+
+	if status.PlayersCount != 2 {
+		t.Errorf("Expected 2 players, got %d", status.PlayersCount)
+	}
+
+	if status.ID != 423762 {
+		t.Errorf("Expected tournament ID 423762, got %d", status.ID)
+	}
+
+	if status.Results["herchu"] != 1 || status.Results["Lord Trooper"] != 0 {
+		t.Errorf("Expected results parsed from the array form, got %v", status.Results)
+	}
+
+	if len(status.Matches) != 2 {
+		t.Fatalf("Expected 2 game tables, got %d", len(status.Matches))
+	}
+
+	first := status.Matches[0]
+	if first.ID != 612345001 || first.Status != "finished" || first.HomeScore != 112 || first.Winner != "herchu" {
+		t.Errorf("Unexpected first table: %+v", first)
+	}
+
+	if status.Matches[1].Status != "in_progress" || status.Matches[1].HomeScore != 0 {
+		t.Errorf("Expected unfinished second table without scores, got %+v", status.Matches[1])
+	}
+}
+
+func TestParseTournamentStatus_ResultsAsMap(t *testing.T) {
+	status, err := parseTournamentStatus([]byte(`{"status":"1","data":{"id":7,"name":"Duelo","status":"finished",` +
+		`"players_nbr":2,"results":{"herchu":2,"webbi":"1"},"tables":[]}}`))
+	if err != nil {
+		t.Fatalf("Expected status to parse, got: %v", err)
+	}
+
+	if status.Results["herchu"] != 2 || status.Results["webbi"] != 1 {
+		t.Errorf("Expected results parsed from the object form, got %v", status.Results)
+	}
+
+	if status.Status != "finished" {
+		t.Errorf("Expected status 'finished', got %s", status.Status)
+	}
+}
+
+func TestParseTournamentStatus_Rejected(t *testing.T) {
+	_, err := parseTournamentStatus([]byte(`{"status":0,"error":"This tournament does not exist"}`))
+	if err == nil || !strings.Contains(err.Error(), "This tournament does not exist") {
+		t.Errorf("Expected BGA's rejection reason in the error, got: %v", err)
+	}
+}
+
+func TestClient_RateLimitSpacesRequests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, `{"status":1,"data":{"id":1,"name":"Duelo","status":"open","players_nbr":2}}`)
+	}))
+	defer server.Close()
+
+	// 600 requests per minute leaves 100ms between requests
+	client := NewClient("user", "pass", WithRateLimit(600))
+	client.baseURL = server.URL
+	if err := client.setSessionCookie("test-session-id"); err != nil {
+		t.Fatalf("Failed to set session cookie: %v", err)
+	}
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		if _, err := client.GetTournamentStatus(context.Background(), 1); err != nil {
+			t.Fatalf("Request %d failed: %v", i+1, err)
+		}
+	}
+
+	if elapsed := time.Since(start); elapsed < 400*time.Millisecond {
+		t.Errorf("Expected 5 requests to take at least 400ms at 600/min, took %v", elapsed)
+	}
+}
+
+func TestClient_RateLimitRespectsContext(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_, _ = io.WriteString(w, `{"status":1,"data":{"id":1,"name":"Duelo","status":"open","players_nbr":2}}`)
+	}))
+	defer server.Close()
+
+	client := NewClient("user", "pass", WithRateLimit(1))
+	client.baseURL = server.URL
+	if err := client.setSessionCookie("test-session-id"); err != nil {
+		t.Fatalf("Failed to set session cookie: %v", err)
+	}
+
+	if _, err := client.GetTournamentStatus(context.Background(), 1); err != nil {
+		t.Fatalf("First request failed: %v", err)
+	}
+
+	// The next slot is a minute away, so the request must give up when the context does
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := client.GetTournamentStatus(ctx, 1)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected deadline exceeded while waiting for the rate limit, got: %v", err)
+	}
+
+	if requests != 1 {
+		t.Errorf("Expected the limited request not to reach the server, got %d requests", requests)
+	}
+}
+
+func TestWithBaseURL(t *testing.T) {
+	if client := NewClient("user", "pass"); client.baseURL != DefaultBaseURL {
+		t.Errorf("Expected default base URL %s, got %s", DefaultBaseURL, client.baseURL)
+	}
+
+	client := NewClient("user", "pass", WithBaseURL("https://staging.example.com/"))
+	if client.baseURL != "https://staging.example.com" {
+		t.Errorf("Expected configured base URL without trailing slash, got %s", client.baseURL)
+	}
+}
+
+func TestClient_EndToEndAgainstFakeBGA(t *testing.T) {
+	var createForm url.Values
+	var paths []string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected request to %s", r.URL.Path)
+		http.NotFound(w, r)
+	})
+	mux.HandleFunc("/account/account/login.html", func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		http.SetCookie(w, &http.Cookie{Name: "PHPSESSID", Value: "abc123", Path: "/"})
+	})
+	mux.HandleFunc("/newtournament/newtournament/create.html", func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("Failed to parse create form: %v", err)
+		}
+		createForm = r.PostForm
+		_, _ = io.WriteString(w, `{"success":true,"tournament_id":424242}`)
+	})
+	mux.HandleFunc("/tournament/tournament/launchTournament.html", func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		_, _ = io.WriteString(w, `{"status":1}`)
+	})
+	mux.HandleFunc("/tournament/tournament/addPlayerToTournament.html", func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		_, _ = io.WriteString(w, `{"status":1}`)
+	})
+	mux.HandleFunc("/tournament/tournament/tournamentStatus.html", func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		_, _ = io.WriteString(w, `{"status":1,"data":{"id":"424242","name":"1 Fecha - Duelo 15 - herchu vs webbi",`+
+			`"status":"open","players_nbr":"2","results":[],"tables":[]}}`)
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	ctx := context.Background()
+	client := NewClient("user", "pass", WithBaseURL(server.URL), WithRateLimit(0))
+
+	if err := client.Login(ctx); err != nil {
+		t.Fatalf("Login failed: %v", err)
+	}
+
+	scheduled := time.Date(2025, 8, 14, 21, 30, 0, 0, time.UTC)
+	resp, err := client.CreateSwissTournamentWithDateTime(ctx, "Elite", "herchu", "webbi", 1, 15, scheduled)
+	if err != nil {
+		t.Fatalf("CreateSwissTournamentWithDateTime failed: %v", err)
+	}
+
+	if resp.Link != server.URL+"/tournament?id=424242" {
+		t.Errorf("Expected link on the configured host, got %s", resp.Link)
+	}
+
+	expectedFields := map[string]string{
+		"form_id":                       "createnewtournament",
+		"game":                          "1",
+		"championship_name":             "Division Elite - 1era Temporada",
+		"tournament_name":               "1 Fecha - Duelo 15 - herchu vs webbi",
+		"base_date":                     "2025-08-14",
+		"base_date_hour":                "21:30",
+		"registration_type":             "invitation_only",
+		"min_players":                   "2",
+		"max_players":                   "2",
+		"stage_type":                    "swissSystemV2",
+		"game_max_duration":             "1800",
+		"mode_option_swissSystemV2_103": "3",
+		"players":                       "confirm_players",
+	}
+	for field, want := range expectedFields {
+		if got := createForm.Get(field); got != want {
+			t.Errorf("Expected create form field %s=%q, got %q", field, want, got)
+		}
+	}
+
+	if err := client.LaunchTournament(ctx, resp.TournamentID); err != nil {
+		t.Fatalf("LaunchTournament failed: %v", err)
+	}
+
+	if err := client.InvitePlayer(ctx, resp.TournamentID, 84000001); err != nil {
+		t.Fatalf("InvitePlayer failed: %v", err)
+	}
+
+	status, err := client.GetTournamentStatus(ctx, resp.TournamentID)
+	if err != nil {
+		t.Fatalf("GetTournamentStatus failed: %v", err)
+	}
+
+	if status.Status != "waiting" {
+		t.Errorf("Expected open tournament reported as waiting, got %s", status.Status)
+	}
+
+	if len(paths) != 5 {
+		t.Errorf("Expected login, create, launch, invite and status on the fake host, got %v", paths)
+	}
+}
+
+func TestClient_DryRun_SkipsPosting(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_, _ = io.WriteString(w, `{"success":true,"tournament_id":424242}`)
+	}))
+	defer server.Close()
+
+	client := NewClient("user", "pass", WithBaseURL(server.URL), WithDryRun())
+	if err := client.setSessionCookie("test-session-id"); err != nil {
+		t.Fatalf("Failed to set session cookie: %v", err)
+	}
+
+	if client.LastFormData() != nil {
+		t.Error("Expected no form data before any creation")
+	}
+
+	ctx := context.Background()
+	resp, err := client.CreateSwissTournament(ctx, "Elite", "herchu", "webbi", 1, 15)
+	if err != nil {
+		t.Fatalf("Dry-run creation failed: %v", err)
+	}
+
+	if !resp.Success || resp.TournamentID == 0 {
+		t.Errorf("Expected a successful synthetic response, got %+v", resp)
+	}
+
+	if id, err := ExtractTournamentID(resp.Link); err != nil || id != resp.TournamentID {
+		t.Errorf("Expected synthetic link to carry the tournament ID, got %s", resp.Link)
+	}
+
+	form := client.LastFormData()
+	if got := form.Get("championship_name"); got != "Division Elite - 1era Temporada" {
+		t.Errorf("Expected championship_name in the dry-run form, got %q", got)
+	}
+
+	if err := client.LaunchTournament(ctx, resp.TournamentID); err != nil {
+		t.Errorf("Expected dry-run launch to succeed, got: %v", err)
+	}
+
+	if err := client.InvitePlayer(ctx, resp.TournamentID, 84000001); err != nil {
+		t.Errorf("Expected dry-run invite to succeed, got: %v", err)
+	}
+
+	if requests != 0 {
+		t.Errorf("Expected no HTTP calls in dry-run mode, got %d", requests)
+	}
+
+	// The returned form is a copy, so callers cannot alter what was recorded
+	form.Set("championship_name", "changed")
+	if client.LastFormData().Get("championship_name") == "changed" {
+		t.Error("Expected LastFormData to return a copy")
+	}
+}
+
+func TestFormatChampionshipName(t *testing.T) {
+	tests := map[int]string{
+		0:  "Division Elite - 1era Temporada",
+		1:  "Division Elite - 1era Temporada",
+		2:  "Division Elite - 2da Temporada",
+		3:  "Division Elite - 3era Temporada",
+		4:  "Division Elite - 4ta Temporada",
+		12: "Division Elite - 12° Temporada",
+	}
+
+	for season, want := range tests {
+		if got := FormatChampionshipName("Elite", season); got != want {
+			t.Errorf("Season %d: expected %q, got %q", season, want, got)
+		}
+	}
+}
+
+func TestNewSwissTournamentConfig_WithSeason(t *testing.T) {
+	config := newSwissTournamentConfig("Elite", "herchu", "webbi", 1, 15, "2025-08-14", "21:00")
+	if config.ChampionshipName != "Division Elite - 1era Temporada" || config.Season != DefaultSeason {
+		t.Errorf("Expected first season by default, got %q season %d", config.ChampionshipName, config.Season)
+	}
+
+	config = newSwissTournamentConfig("Elite", "herchu", "webbi", 1, 15, "2025-08-14", "21:00", WithSeason(2))
+	if config.ChampionshipName != "Division Elite - 2da Temporada" || config.Season != 2 {
+		t.Errorf("Expected second season championship, got %q season %d", config.ChampionshipName, config.Season)
+	}
+}
+
+func TestClient_CreateTournament_PresetRegistrationType(t *testing.T) {
+	client := NewClient("user", "pass", WithDryRun())
+	if err := client.setSessionCookie("test-session-id"); err != nil {
+		t.Fatalf("Failed to set session cookie: %v", err)
+	}
+
+	friendly, ok := PresetByName("friendly")
+	if !ok {
+		t.Fatal("Expected the Friendly preset to be found regardless of case")
+	}
+
+	ctx := context.Background()
+	if _, err := client.CreateSwissTournament(ctx, "Elite", "herchu", "webbi", 1, 15, WithPreset(friendly)); err != nil {
+		t.Fatalf("Friendly creation failed: %v", err)
+	}
+	if got := client.LastFormData().Get("registration_type"); got != RegistrationOpen {
+		t.Errorf("Expected the Friendly preset to open registration, got %q", got)
+	}
+
+	_, err := client.CreateSwissTournament(ctx, "Elite", "herchu", "webbi", 1, 15, WithPreset(PresetRegular))
+	if err != nil {
+		t.Fatalf("Regular creation failed: %v", err)
+	}
+	if got := client.LastFormData().Get("registration_type"); got != RegistrationInvitationOnly {
+		t.Errorf("Expected the Regular preset to stay invitation only, got %q", got)
+	}
+
+	if _, ok := PresetByName("Casual"); ok {
+		t.Error("Expected an unknown preset not to be found")
+	}
+}
+
+func TestClient_CreateTournament_RejectsInvalidRegistrationType(t *testing.T) {
+	client := NewClient("user", "pass", WithDryRun())
+	if err := client.setSessionCookie("test-session-id"); err != nil {
+		t.Fatalf("Failed to set session cookie: %v", err)
+	}
+
+	_, err := client.CreateSwissTournament(context.Background(), "Elite", "herchu", "webbi", 1, 15,
+		WithRegistrationType("members_only"))
+	if err == nil || !strings.Contains(err.Error(), `invalid registration type "members_only"`) {
+		t.Fatalf("Expected an invalid registration type error, got %v", err)
+	}
+	if client.LastFormData() != nil {
+		t.Error("Expected nothing to be submitted for an invalid registration type")
+	}
+
+	mock := NewMockClient("user", "pass")
+	if err := mock.Login(context.Background()); err != nil {
+		t.Fatalf("Mock login failed: %v", err)
+	}
+	if _, err := mock.CreateSwissTournament(context.Background(), "Elite", "herchu", "webbi", 1, 15,
+		WithRegistrationType("members_only")); err == nil {
+		t.Error("Expected the mock client to reject an invalid registration type too")
+	}
+}
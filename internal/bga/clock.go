@@ -0,0 +1,6 @@
+package bga
+
+import "time"
+
+// now returns the current time; tests replace it to freeze the clock
+var now = time.Now
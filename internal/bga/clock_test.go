@@ -0,0 +1,56 @@
+package bga
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// freezeClock makes now return at for the rest of the test
+func freezeClock(t *testing.T, at time.Time) {
+	t.Helper()
+
+	original := now
+	now = func() time.Time { return at }
+	t.Cleanup(func() { now = original })
+}
+
+func TestCreateSwissTournament_DefaultScheduleUsesClock(t *testing.T) {
+	freezeClock(t, time.Date(2025, 9, 14, 10, 0, 0, 0, time.UTC))
+
+	client := NewClient("user", "pass", WithDryRun())
+	if err := client.setSessionCookie("test-session-id"); err != nil {
+		t.Fatalf("Failed to set session cookie: %v", err)
+	}
+
+	if _, err := client.CreateSwissTournament(context.Background(), "Elite", "herchu", "webbi", 1, 1); err != nil {
+		t.Fatalf("Dry-run creation failed: %v", err)
+	}
+
+	form := client.LastFormData()
+	if got := form.Get("base_date"); got != "2025-09-14" {
+		t.Errorf("Expected base_date to be the frozen date, got %q", got)
+	}
+
+	if got := form.Get("base_date_hour"); got != "21:00" {
+		t.Errorf("Expected the default 21:00 start, got %q", got)
+	}
+}
+
+func TestIsSessionExpired_UsesClock(t *testing.T) {
+	client := NewClient("user", "pass")
+	if err := client.setSessionCookie("test-session-id"); err != nil {
+		t.Fatalf("Failed to set session cookie: %v", err)
+	}
+	client.sessionExpiry = time.Date(2025, 9, 14, 12, 0, 0, 0, time.UTC)
+
+	freezeClock(t, time.Date(2025, 9, 14, 11, 59, 0, 0, time.UTC))
+	if client.IsSessionExpired() {
+		t.Error("Expected the session to be valid before its expiry")
+	}
+
+	freezeClock(t, time.Date(2025, 9, 14, 12, 0, 0, 0, time.UTC))
+	if !client.IsSessionExpired() {
+		t.Error("Expected the session to expire at its expiry time")
+	}
+}
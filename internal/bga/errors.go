@@ -0,0 +1,31 @@
+package bga
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Errors returned by Client and MockClient, usually wrapped with more context
+var (
+	// ErrNotAuthenticated is returned when an operation needs a session but none is active
+	ErrNotAuthenticated = errors.New("not authenticated: call Login() first")
+	// ErrInvalidCredentials is returned when BGA refuses the username and password
+	ErrInvalidCredentials = errors.New("invalid credentials")
+	// ErrTournamentNotFound is returned when BGA knows no tournament with the requested ID
+	ErrTournamentNotFound = errors.New("tournament not found")
+	// ErrTournamentNotFinished is returned when asking for the result of a duel still being played
+	ErrTournamentNotFinished = errors.New("tournament not finished")
+	// ErrTransient is returned for temporary BGA failures that are worth retrying
+	ErrTransient = errors.New("temporary BGA failure, try again")
+)
+
+// ValidationError reports a request rejected before reaching BGA because of a bad field
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+// Error describes the offending field
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid %s: %s", e.Field, e.Message)
+}
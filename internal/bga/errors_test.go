@@ -0,0 +1,52 @@
+package bga
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestClient_CreateTournament_NotAuthenticated(t *testing.T) {
+	client := NewClient("user", "pass")
+
+	_, err := client.CreateTournament(context.Background(), &TournamentConfig{})
+	if !errors.Is(err, ErrNotAuthenticated) {
+		t.Errorf("Expected ErrNotAuthenticated, got: %v", err)
+	}
+}
+
+func TestMockClient_TypedErrors(t *testing.T) {
+	ctx := context.Background()
+	mockClient := NewMockClient("testuser", "testpass")
+
+	if _, err := mockClient.CreateTournament(ctx, &TournamentConfig{}); !errors.Is(err, ErrNotAuthenticated) {
+		t.Errorf("Expected ErrNotAuthenticated before login, got: %v", err)
+	}
+
+	mockClient.SetShouldFailLogin(true)
+	if err := mockClient.Login(ctx); !errors.Is(err, ErrInvalidCredentials) {
+		t.Errorf("Expected ErrInvalidCredentials, got: %v", err)
+	}
+
+	mockClient.SetShouldFailLogin(false)
+	if err := mockClient.Login(ctx); err != nil {
+		t.Fatalf("Failed to login: %v", err)
+	}
+
+	if _, err := mockClient.GetTournamentStatus(ctx, 999999); !errors.Is(err, ErrTournamentNotFound) {
+		t.Errorf("Expected ErrTournamentNotFound, got: %v", err)
+	}
+
+	var validationErr *ValidationError
+	_, err := mockClient.ResolvePlayerID(ctx, "")
+	if !errors.As(err, &validationErr) || validationErr.Field != "username" {
+		t.Errorf("Expected a ValidationError on the username field, got: %v", err)
+	}
+}
+
+func TestClient_Login_MissingCredentials(t *testing.T) {
+	var validationErr *ValidationError
+	if err := NewClient("", "").Login(context.Background()); !errors.As(err, &validationErr) {
+		t.Errorf("Expected a ValidationError for missing credentials, got: %v", err)
+	}
+}
@@ -1,30 +1,52 @@
 package bga
 
-import "time"
+import (
+	"context"
+	"time"
+)
 
 // APIClient defines the interface for interacting with BoardGameArena
 type APIClient interface {
 	// Login authenticates with BGA and establishes a session
-	Login() error
+	Login(ctx context.Context) error
 
 	// CreateTournament creates a new tournament with the given configuration
-	CreateTournament(config *TournamentConfig) (*TournamentResponse, error)
+	CreateTournament(ctx context.Context, config *TournamentConfig) (*TournamentResponse, error)
 
-	// CreateSwissTournament creates a best-of-3 Swiss tournament for two players
+	// CreateSwissTournament creates a Swiss tournament for two players, best-of-3 unless options say otherwise
 	CreateSwissTournament(
+		ctx context.Context,
 		division, homePlayer, awayPlayer string,
 		roundNumber, matchNumber int,
+		opts ...TournamentOption,
 	) (*TournamentResponse, error)
 
-	// CreateSwissTournamentWithDateTime creates a best-of-3 Swiss tournament for two players with specific datetime
+	// CreateSwissTournamentWithDateTime creates a Swiss tournament for two players at a specific datetime
 	CreateSwissTournamentWithDateTime(
+		ctx context.Context,
 		division, homePlayer, awayPlayer string,
 		roundNumber, matchNumber int,
 		scheduledTime time.Time,
+		opts ...TournamentOption,
 	) (*TournamentResponse, error)
 
 	// GetTournamentStatus retrieves the current status of a tournament
-	GetTournamentStatus(tournamentID int) (*TournamentStatus, error)
+	GetTournamentStatus(ctx context.Context, tournamentID int) (*TournamentStatus, error)
+
+	// GetTournamentResult tells who won the Duelo and the games each player took
+	GetTournamentResult(ctx context.Context, tournamentID int) (winner string, homeWins, awayWins int, err error)
+
+	// LaunchTournament opens a created tournament so players can join
+	LaunchTournament(ctx context.Context, tournamentID int) error
+
+	// InvitePlayer invites a player to a launched tournament
+	InvitePlayer(ctx context.Context, tournamentID, playerID int) error
+
+	// ReportMatchResult submits the final score of a game table in a tournament
+	ReportMatchResult(ctx context.Context, tournamentID, gameTableID, homeScore, awayScore int) error
+
+	// ResolvePlayerID looks up the BGA player ID needed to invite a username
+	ResolvePlayerID(ctx context.Context, username string) (int, error)
 
 	// IsAuthenticated checks if the client has a valid session
 	IsAuthenticated() bool
@@ -0,0 +1,34 @@
+package bga
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// CheckLogin logs in to verify the credentials, then logs out again without creating anything
+// It returns the redacted session ID BGA handed out, so callers can show a session was established
+func (c *Client) CheckLogin(ctx context.Context) (redactedSession string, err error) {
+	if err := c.Login(ctx); err != nil {
+		return "", err
+	}
+
+	redactedSession = RedactSessionID(c.sessionID)
+
+	if err := c.Logout(); err != nil {
+		return redactedSession, fmt.Errorf("logged in but failed to log out: %w", err)
+	}
+
+	return redactedSession, nil
+}
+
+// RedactSessionID keeps only the first characters of a session ID, enough to tell sessions apart
+func RedactSessionID(sessionID string) string {
+	const visible = 4
+
+	if len(sessionID) <= visible {
+		return strings.Repeat("*", len(sessionID))
+	}
+
+	return sessionID[:visible] + strings.Repeat("*", len(sessionID)-visible)
+}
@@ -0,0 +1,91 @@
+package bga
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// loginCheckServer fakes the BGA login and logout endpoints, counting logouts
+func loginCheckServer(t *testing.T, acceptLogin bool, logouts *int) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/account/account/login.html", func(w http.ResponseWriter, r *http.Request) {
+		if acceptLogin {
+			http.SetCookie(w, &http.Cookie{Name: "PHPSESSID", Value: "abcdef123456", Path: "/"})
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("/account/account/logout.html", func(w http.ResponseWriter, r *http.Request) {
+		*logouts++
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Expected no other requests, got %s %s", r.Method, r.URL.Path)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestClient_CheckLogin_Success(t *testing.T) {
+	logouts := 0
+	server := loginCheckServer(t, true, &logouts)
+	client := NewClient("user", "pass", WithBaseURL(server.URL), WithRateLimit(0))
+
+	session, err := client.CheckLogin(context.Background())
+	if err != nil {
+		t.Fatalf("Expected the check to pass, got: %v", err)
+	}
+
+	if session != "abcd********" {
+		t.Errorf("Expected the redacted session ID, got %q", session)
+	}
+
+	if logouts != 1 {
+		t.Errorf("Expected one logout, got %d", logouts)
+	}
+
+	if client.IsAuthenticated() {
+		t.Error("Expected the client to be logged out after the check")
+	}
+}
+
+func TestClient_CheckLogin_Failure(t *testing.T) {
+	logouts := 0
+	server := loginCheckServer(t, false, &logouts)
+	client := NewClient("user", "wrong", WithBaseURL(server.URL), WithRateLimit(0))
+
+	session, err := client.CheckLogin(context.Background())
+	if !errors.Is(err, ErrInvalidCredentials) {
+		t.Fatalf("Expected ErrInvalidCredentials, got: %v", err)
+	}
+
+	if session != "" {
+		t.Errorf("Expected no session to report, got %q", session)
+	}
+
+	if logouts != 0 {
+		t.Errorf("Expected no logout without a session, got %d", logouts)
+	}
+}
+
+func TestRedactSessionID(t *testing.T) {
+	testCases := map[string]string{
+		"":             "",
+		"abc":          "***",
+		"abcd":         "****",
+		"abcdef123456": "abcd********",
+	}
+
+	for sessionID, want := range testCases {
+		if got := RedactSessionID(sessionID); got != want {
+			t.Errorf("RedactSessionID(%q) = %q, want %q", sessionID, got, want)
+		}
+	}
+}
@@ -1,7 +1,9 @@
 package bga
 
 import (
+	"context"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -10,12 +12,17 @@ import (
 // MockClient is a mock implementation of the BGA client for testing
 type MockClient struct {
 	tournaments      map[int]*TournamentStatus
+	playerIDs        map[string]int
+	invited          map[int][]int
 	username         string
 	password         string
 	nextTournamentID int
 	isAuthenticated  bool
 	shouldFailLogin  bool
 	shouldFailCreate bool
+	failNextCreates  int
+	transientFails   int
+	latency          time.Duration
 }
 
 // NewMockClient creates a new mock BGA client
@@ -24,6 +31,8 @@ func NewMockClient(username, password string) *MockClient {
 		username:         username,
 		password:         password,
 		tournaments:      make(map[int]*TournamentStatus),
+		playerIDs:        make(map[string]int),
+		invited:          make(map[int][]int),
 		nextTournamentID: 423762, // Start with a realistic tournament ID
 	}
 }
@@ -38,27 +47,57 @@ func (m *MockClient) SetShouldFailCreate(shouldFail bool) {
 	m.shouldFailCreate = shouldFail
 }
 
+// SetLatency sets how long every mock call waits before answering, zero by default
+func (m *MockClient) SetLatency(d time.Duration) {
+	m.latency = d
+}
+
+// SetTransientFailures makes the next n tournament creations fail with ErrTransient before succeeding
+func (m *MockClient) SetTransientFailures(n int) {
+	m.transientFails = n
+}
+
+// FailNextCreates makes BGA reject the next n tournament creations with an unsuccessful response
+func (m *MockClient) FailNextCreates(n int) {
+	m.failNextCreates = n
+}
+
 // Login simulates authentication with BGA
-func (m *MockClient) Login() error {
+func (m *MockClient) Login(ctx context.Context) error {
 	if m.shouldFailLogin {
-		return fmt.Errorf("authentication failed: invalid credentials")
+		return fmt.Errorf("authentication failed: %w", ErrInvalidCredentials)
 	}
 
 	if m.username == "" || m.password == "" {
-		return fmt.Errorf("username and password are required")
+		return &ValidationError{Field: "credentials", Message: "username and password are required"}
 	}
 
 	// Simulate authentication delay
-	time.Sleep(100 * time.Millisecond)
+	if err := sleepContext(ctx, m.latency); err != nil {
+		return err
+	}
 
 	m.isAuthenticated = true
 	return nil
 }
 
 // CreateTournament simulates creating a tournament on BGA
-func (m *MockClient) CreateTournament(config *TournamentConfig) (*TournamentResponse, error) {
+func (m *MockClient) CreateTournament(ctx context.Context, config *TournamentConfig) (*TournamentResponse, error) {
 	if !m.isAuthenticated {
-		return nil, fmt.Errorf("not authenticated: call Login() first")
+		return nil, ErrNotAuthenticated
+	}
+
+	if m.transientFails > 0 {
+		m.transientFails--
+		return nil, fmt.Errorf("tournament creation failed: %w", ErrTransient)
+	}
+
+	if m.failNextCreates > 0 {
+		m.failNextCreates--
+		return &TournamentResponse{
+			Success: false,
+			Error:   "tournament creation failed: server error",
+		}, nil
 	}
 
 	if m.shouldFailCreate {
@@ -68,6 +107,10 @@ func (m *MockClient) CreateTournament(config *TournamentConfig) (*TournamentResp
 		}, nil
 	}
 
+	if err := validateRegistrationType(config.registrationType()); err != nil {
+		return nil, err
+	}
+
 	// Validate required fields
 	if config.LocalPlayer == "" || config.VisitorPlayer == "" {
 		return &TournamentResponse{
@@ -83,6 +126,11 @@ func (m *MockClient) CreateTournament(config *TournamentConfig) (*TournamentResp
 		}, nil
 	}
 
+	// Simulate network delay before anything is recorded so a canceled request leaves no trace
+	if err := sleepContext(ctx, m.latency); err != nil {
+		return nil, err
+	}
+
 	// Generate tournament ID and create response
 	tournamentID := m.nextTournamentID
 	m.nextTournamentID++
@@ -95,32 +143,7 @@ func (m *MockClient) CreateTournament(config *TournamentConfig) (*TournamentResp
 		Name:         config.TournamentName,
 		Status:       "waiting",
 		PlayersCount: 2,
-		Matches: []MatchStatus{
-			{
-				ID:         1,
-				Status:     "waiting",
-				HomePlayer: config.LocalPlayer,
-				AwayPlayer: config.VisitorPlayer,
-				HomeScore:  0,
-				AwayScore:  0,
-			},
-			{
-				ID:         2,
-				Status:     "waiting",
-				HomePlayer: config.LocalPlayer,
-				AwayPlayer: config.VisitorPlayer,
-				HomeScore:  0,
-				AwayScore:  0,
-			},
-			{
-				ID:         3,
-				Status:     "waiting",
-				HomePlayer: config.LocalPlayer,
-				AwayPlayer: config.VisitorPlayer,
-				HomeScore:  0,
-				AwayScore:  0,
-			},
-		},
+		Matches:      mockMatches(config),
 		Results: map[string]int{
 			config.LocalPlayer:   0,
 			config.VisitorPlayer: 0,
@@ -129,9 +152,6 @@ func (m *MockClient) CreateTournament(config *TournamentConfig) (*TournamentResp
 
 	m.tournaments[tournamentID] = status
 
-	// Simulate network delay
-	time.Sleep(200 * time.Millisecond)
-
 	return &TournamentResponse{
 		Success:      true,
 		TournamentID: tournamentID,
@@ -139,82 +159,78 @@ func (m *MockClient) CreateTournament(config *TournamentConfig) (*TournamentResp
 	}, nil
 }
 
-// CreateSwissTournament creates a mock best-of-3 Swiss tournament
+// mockMatches creates one waiting game table per game of the duel
+func mockMatches(config *TournamentConfig) []MatchStatus {
+	matches := make([]MatchStatus, config.MatchesCount)
+	for i := range matches {
+		matches[i] = MatchStatus{
+			ID:         i + 1,
+			Status:     "waiting",
+			HomePlayer: config.LocalPlayer,
+			AwayPlayer: config.VisitorPlayer,
+		}
+	}
+
+	return matches
+}
+
+// CreateSwissTournament creates a mock Swiss tournament, best-of-3 unless options say otherwise
 func (m *MockClient) CreateSwissTournament(
+	ctx context.Context,
 	division, homePlayer, awayPlayer string,
 	roundNumber, matchNumber int,
+	opts ...TournamentOption,
 ) (*TournamentResponse, error) {
-	// Format championship and tournament names according to requirements
-	championshipName := fmt.Sprintf("Division %s - 1era Temporada", division)
-	tournamentName := fmt.Sprintf("%d Fecha - Duelo %d - %s vs %s", roundNumber, matchNumber, homePlayer, awayPlayer)
-
-	config := &TournamentConfig{
-		GameID:           1,
-		ChampionshipName: championshipName,
-		TournamentName:   tournamentName,
-		MaxPlayers:       2,
-		MinPlayers:       2,
-		BaseDate:         time.Now().Format("2006-01-02"),
-		BaseDateTime:     "21:00",
-		GameDuration:     1800,
-		MatchesCount:     3,
-		Division:         division,
-		RoundNumber:      roundNumber,
-		MatchNumber:      matchNumber,
-		LocalPlayer:      homePlayer,
-		VisitorPlayer:    awayPlayer,
-	}
-
-	return m.CreateTournament(config)
+	baseDate, baseDateTime := defaultSchedule()
+	config := newSwissTournamentConfig(
+		division, homePlayer, awayPlayer, roundNumber, matchNumber, baseDate, baseDateTime, opts...,
+	)
+
+	return m.CreateTournament(ctx, config)
 }
 
-// CreateSwissTournamentWithDateTime creates a best-of-3 Swiss tournament for two players with specific datetime
+// CreateSwissTournamentWithDateTime creates a Swiss tournament for two players at a specific datetime
 func (m *MockClient) CreateSwissTournamentWithDateTime(
+	ctx context.Context,
 	division, homePlayer, awayPlayer string,
 	roundNumber, matchNumber int,
 	scheduledTime time.Time,
+	opts ...TournamentOption,
 ) (*TournamentResponse, error) {
-	// Format championship and tournament names according to requirements
-	championshipName := fmt.Sprintf("Division %s - 1era Temporada", division)
-	tournamentName := fmt.Sprintf("%d Fecha - Duelo %d - %s vs %s", roundNumber, matchNumber, homePlayer, awayPlayer)
-
-	config := &TournamentConfig{
-		GameID:           1,
-		ChampionshipName: championshipName,
-		TournamentName:   tournamentName,
-		MaxPlayers:       2,
-		MinPlayers:       2,
-		BaseDate:         scheduledTime.Format("2006-01-02"),
-		BaseDateTime:     scheduledTime.Format("15:04"),
-		GameDuration:     1800,
-		MatchesCount:     3,
-		Division:         division,
-		RoundNumber:      roundNumber,
-		MatchNumber:      matchNumber,
-		LocalPlayer:      homePlayer,
-		VisitorPlayer:    awayPlayer,
-	}
-
-	return m.CreateTournament(config)
+	config := newSwissTournamentConfig(
+		division, homePlayer, awayPlayer, roundNumber, matchNumber,
+		scheduledTime.Format("2006-01-02"), scheduledTime.Format("15:04"), opts...,
+	)
+
+	return m.CreateTournament(ctx, config)
 }
 
 // GetTournamentStatus returns the mock status of a tournament
-func (m *MockClient) GetTournamentStatus(tournamentID int) (*TournamentStatus, error) {
+func (m *MockClient) GetTournamentStatus(ctx context.Context, tournamentID int) (*TournamentStatus, error) {
 	if !m.isAuthenticated {
-		return nil, fmt.Errorf("not authenticated: call Login() first")
+		return nil, ErrNotAuthenticated
 	}
 
 	status, exists := m.tournaments[tournamentID]
 	if !exists {
-		return nil, fmt.Errorf("tournament not found: %d", tournamentID)
+		return nil, fmt.Errorf("%w: %d", ErrTournamentNotFound, tournamentID)
 	}
 
 	// Return a copy to prevent external modification
-	statusCopy := *status
-	statusCopy.Matches = make([]MatchStatus, len(status.Matches))
-	copy(statusCopy.Matches, status.Matches)
+	return copyTournamentStatus(status), nil
+}
 
-	return &statusCopy, nil
+// GetTournamentResult derives the Duelo winner from the tracked mock matches
+func (m *MockClient) GetTournamentResult(
+	ctx context.Context,
+	tournamentID int,
+) (winner string, homeWins, awayWins int, err error) {
+	status, err := m.GetTournamentStatus(ctx, tournamentID)
+	if err != nil {
+		return "", 0, 0, err
+	}
+
+	return duelResult(status)
 }
 
 // IsAuthenticated returns whether the mock client is authenticated
@@ -232,7 +248,7 @@ func (m *MockClient) Logout() error {
 func (m *MockClient) SimulateMatchResult(tournamentID, matchID, homeScore, awayScore int, winner string) error {
 	status, exists := m.tournaments[tournamentID]
 	if !exists {
-		return fmt.Errorf("tournament not found: %d", tournamentID)
+		return fmt.Errorf("%w: %d", ErrTournamentNotFound, tournamentID)
 	}
 
 	for i := range status.Matches {
@@ -270,18 +286,81 @@ func (m *MockClient) SimulateMatchResult(tournamentID, matchID, homeScore, awayS
 	return nil
 }
 
+// ReportMatchResult records the score of a mock game table, deciding the winner from the scores
+func (m *MockClient) ReportMatchResult(ctx context.Context, tournamentID, gameTableID, homeScore, awayScore int) error {
+	if !m.isAuthenticated {
+		return ErrNotAuthenticated
+	}
+
+	status, exists := m.tournaments[tournamentID]
+	if !exists {
+		return fmt.Errorf("%w: %d", ErrTournamentNotFound, tournamentID)
+	}
+
+	var match *MatchStatus
+	for i := range status.Matches {
+		if status.Matches[i].ID == gameTableID {
+			match = &status.Matches[i]
+			break
+		}
+	}
+
+	if match == nil {
+		return fmt.Errorf("game table %d not found in tournament %d", gameTableID, tournamentID)
+	}
+
+	if match.Status == "finished" {
+		return fmt.Errorf("game table %d already has a result", gameTableID)
+	}
+
+	// Simulate network delay
+	if err := sleepContext(ctx, m.latency); err != nil {
+		return err
+	}
+
+	winner := ""
+	switch {
+	case homeScore > awayScore:
+		winner = match.HomePlayer
+	case awayScore > homeScore:
+		winner = match.AwayPlayer
+	}
+
+	return m.SimulateMatchResult(tournamentID, gameTableID, homeScore, awayScore, winner)
+}
+
 // GetTournaments returns all tournaments created by this mock client
 func (m *MockClient) GetTournaments() map[int]*TournamentStatus {
 	tournaments := make(map[int]*TournamentStatus)
 	for id, status := range m.tournaments {
-		statusCopy := *status
-		statusCopy.Matches = make([]MatchStatus, len(status.Matches))
-		copy(statusCopy.Matches, status.Matches)
-		tournaments[id] = &statusCopy
+		tournaments[id] = copyTournamentStatus(status)
 	}
 	return tournaments
 }
 
+// ListTournamentsSorted returns all tournaments created by this mock client ordered by tournament ID
+func (m *MockClient) ListTournamentsSorted() []*TournamentStatus {
+	tournaments := make([]*TournamentStatus, 0, len(m.tournaments))
+	for _, status := range m.tournaments {
+		tournaments = append(tournaments, copyTournamentStatus(status))
+	}
+
+	sort.Slice(tournaments, func(i, j int) bool {
+		return tournaments[i].ID < tournaments[j].ID
+	})
+
+	return tournaments
+}
+
+// copyTournamentStatus copies a tracked tournament so callers cannot modify it
+func copyTournamentStatus(status *TournamentStatus) *TournamentStatus {
+	statusCopy := *status
+	statusCopy.Matches = make([]MatchStatus, len(status.Matches))
+	copy(statusCopy.Matches, status.Matches)
+
+	return &statusCopy
+}
+
 // ExtractTournamentID extracts tournament ID from a BGA tournament URL
 func ExtractTournamentID(link string) (int, error) {
 	if link == "" {
@@ -309,14 +388,14 @@ func ExtractTournamentID(link string) (int, error) {
 }
 
 // LaunchTournament simulates launching a created tournament
-func (m *MockClient) LaunchTournament(tournamentID int) error {
+func (m *MockClient) LaunchTournament(ctx context.Context, tournamentID int) error {
 	if !m.isAuthenticated {
-		return fmt.Errorf("not authenticated: call Login() first")
+		return ErrNotAuthenticated
 	}
 
 	tournament, exists := m.tournaments[tournamentID]
 	if !exists {
-		return fmt.Errorf("tournament with ID %d not found", tournamentID)
+		return fmt.Errorf("%w: %d", ErrTournamentNotFound, tournamentID)
 	}
 
 	// Change tournament status from "created" or "waiting" to "open"
@@ -325,20 +404,22 @@ func (m *MockClient) LaunchTournament(tournamentID int) error {
 	}
 
 	// Simulate network delay
-	time.Sleep(100 * time.Millisecond)
+	if err := sleepContext(ctx, m.latency); err != nil {
+		return err
+	}
 
 	return nil
 }
 
 // InvitePlayer simulates inviting a player to a tournament
-func (m *MockClient) InvitePlayer(tournamentID int, playerID string) error {
+func (m *MockClient) InvitePlayer(ctx context.Context, tournamentID, playerID int) error {
 	if !m.isAuthenticated {
-		return fmt.Errorf("not authenticated: call Login() first")
+		return ErrNotAuthenticated
 	}
 
 	tournament, exists := m.tournaments[tournamentID]
 	if !exists {
-		return fmt.Errorf("tournament with ID %d not found", tournamentID)
+		return fmt.Errorf("%w: %d", ErrTournamentNotFound, tournamentID)
 	}
 
 	// Validate that tournament is in open state (launched)
@@ -347,16 +428,60 @@ func (m *MockClient) InvitePlayer(tournamentID int, playerID string) error {
 	}
 
 	// Simulate network delay
-	time.Sleep(50 * time.Millisecond)
+	if err := sleepContext(ctx, m.latency); err != nil {
+		return err
+	}
 
+	m.invited[tournamentID] = append(m.invited[tournamentID], playerID)
 	return nil
 }
 
+// InvitedPlayers returns the IDs of the players invited to a tournament, in invitation order
+func (m *MockClient) InvitedPlayers(tournamentID int) []int {
+	return m.invited[tournamentID]
+}
+
+// SetPlayerIDs seeds the IDs returned by ResolvePlayerID for the given usernames
+func (m *MockClient) SetPlayerIDs(ids map[string]int) {
+	for username, id := range ids {
+		m.playerIDs[username] = id
+	}
+}
+
+// ResolvePlayerID returns the seeded ID for a username, handing out stable new IDs for unknown ones
+func (m *MockClient) ResolvePlayerID(ctx context.Context, username string) (int, error) {
+	if !m.isAuthenticated {
+		return 0, ErrNotAuthenticated
+	}
+
+	if username == "" {
+		return 0, &ValidationError{Field: "username", Message: "player username is required"}
+	}
+
+	if id, ok := m.playerIDs[username]; ok {
+		return id, nil
+	}
+
+	// Simulate network delay
+	if err := sleepContext(ctx, m.latency); err != nil {
+		return 0, err
+	}
+
+	id := 84000000 + len(m.playerIDs)
+	m.playerIDs[username] = id
+
+	return id, nil
+}
+
 // Reset clears all tournament data from the mock client
 func (m *MockClient) Reset() {
 	m.tournaments = make(map[int]*TournamentStatus)
+	m.playerIDs = make(map[string]int)
+	m.invited = make(map[int][]int)
 	m.nextTournamentID = 423762
 	m.isAuthenticated = false
 	m.shouldFailLogin = false
 	m.shouldFailCreate = false
+	m.failNextCreates = 0
+	m.transientFails = 0
 }
@@ -0,0 +1,55 @@
+package bga
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// DefaultRateLimit is the number of BGA requests per minute allowed when no rate limit option is given
+const DefaultRateLimit = 20
+
+// rateLimiter spaces requests at least interval apart, making callers wait their turn
+type rateLimiter struct {
+	next     time.Time // Earliest time the next request may be sent
+	mu       sync.Mutex
+	interval time.Duration
+}
+
+// newRateLimiter allows perMinute requests per minute; zero or less disables limiting
+func newRateLimiter(perMinute int) *rateLimiter {
+	if perMinute <= 0 {
+		return nil
+	}
+
+	return &rateLimiter{interval: time.Minute / time.Duration(perMinute)}
+}
+
+// wait blocks until a request may be sent or the context is done
+func (l *rateLimiter) wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	delay := l.next.Sub(now)
+	l.next = l.next.Add(l.interval)
+	l.mu.Unlock()
+
+	if delay == 0 {
+		return ctx.Err()
+	}
+
+	return sleepContext(ctx, delay)
+}
+
+// WithRateLimit caps how many requests per minute the client sends to BGA; zero or less disables the cap
+func WithRateLimit(perMinute int) ClientOption {
+	return func(c *Client) {
+		c.limiter = newRateLimiter(perMinute)
+	}
+}
@@ -0,0 +1,84 @@
+package bga
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// sessionExpiredMarkers are body fragments BGA sends when a request arrives without a valid session
+var sessionExpiredMarkers = []string{"not logged in", "not connected", "must be logged in"}
+
+// WithAutoReauth makes the client log in again and retry once when BGA reports the session expired
+func WithAutoReauth() ClientOption {
+	return func(c *Client) {
+		c.autoReauth = true
+	}
+}
+
+// isSessionExpiredResponse reports whether BGA redirected to the login page or refused for lack of a session
+func isSessionExpiredResponse(resp *http.Response, body []byte) bool {
+	if resp.Request != nil && strings.Contains(resp.Request.URL.Path, "/account/account/login") {
+		return true
+	}
+
+	lower := strings.ToLower(string(body))
+	for _, marker := range sessionExpiredMarkers {
+		if strings.Contains(lower, marker) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// reauthenticate drops the stale session and logs in again with the stored credentials
+func (c *Client) reauthenticate(ctx context.Context) error {
+	c.httpClient.Jar = newCookieJar()
+	c.sessionID = ""
+
+	if err := c.Login(ctx); err != nil {
+		return fmt.Errorf("session expired and re-login failed: %w", err)
+	}
+
+	return nil
+}
+
+// doReadingBody performs the request with retries and returns the response along with its whole body
+// With auto re-authentication on, an expired session is renewed and the request sent once more
+func (c *Client) doReadingBody(
+	ctx context.Context,
+	newRequest func() (*http.Request, error),
+) (*http.Response, []byte, error) {
+	resp, body, err := c.doOnceReadingBody(ctx, newRequest)
+	if err != nil || !c.autoReauth || !isSessionExpiredResponse(resp, body) {
+		return resp, body, err
+	}
+
+	if err := c.reauthenticate(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	return c.doOnceReadingBody(ctx, newRequest)
+}
+
+// doOnceReadingBody performs the request with retries and reads its body
+func (c *Client) doOnceReadingBody(
+	ctx context.Context,
+	newRequest func() (*http.Request, error),
+) (*http.Response, []byte, error) {
+	resp, err := c.doWithRetry(ctx, newRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	return resp, body, nil
+}
@@ -0,0 +1,118 @@
+package bga
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// newExpiringBGA fakes a BGA host that only accepts the session cookie handed out by its login page
+func newExpiringBGA(t *testing.T, logins, creates *int) *httptest.Server {
+	t.Helper()
+
+	loggedIn := func(r *http.Request) bool {
+		cookie, err := r.Cookie("PHPSESSID")
+		return err == nil && cookie.Value == "fresh"
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/account/account/login.html", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			_, _ = io.WriteString(w, "<html>Please log in</html>")
+			return
+		}
+		*logins++
+		http.SetCookie(w, &http.Cookie{Name: "PHPSESSID", Value: "fresh", Path: "/"})
+	})
+	mux.HandleFunc("/newtournament/newtournament/create.html", func(w http.ResponseWriter, r *http.Request) {
+		*creates++
+		if !loggedIn(r) {
+			_, _ = io.WriteString(w, `{"status":"0","error":"You are not logged in"}`)
+			return
+		}
+		_, _ = io.WriteString(w, `{"success":true,"tournament_id":424242}`)
+	})
+	mux.HandleFunc("/tournament/tournament/tournamentStatus.html", func(w http.ResponseWriter, r *http.Request) {
+		if !loggedIn(r) {
+			http.Redirect(w, r, "/account/account/login.html", http.StatusFound)
+			return
+		}
+		_, _ = io.WriteString(w, `{"status":1,"data":{"id":"424242","name":"Duelo","status":"open","players_nbr":"2"}}`)
+	})
+
+	return httptest.NewServer(mux)
+}
+
+func TestClient_AutoReauth_RetriesCreateAfterLogin(t *testing.T) {
+	var logins, creates int
+	server := newExpiringBGA(t, &logins, &creates)
+	defer server.Close()
+
+	client := NewClient("user", "pass", WithBaseURL(server.URL), WithRateLimit(0), WithAutoReauth())
+	if err := client.setSessionCookie("stale"); err != nil {
+		t.Fatalf("Failed to set session cookie: %v", err)
+	}
+
+	resp, err := client.CreateSwissTournament(context.Background(), "Elite", "herchu", "webbi", 1, 15)
+	if err != nil {
+		t.Fatalf("Expected creation to succeed after re-login, got: %v", err)
+	}
+
+	if !resp.Success || resp.TournamentID != 424242 {
+		t.Errorf("Expected the retried creation to succeed, got %+v", resp)
+	}
+
+	if logins != 1 || creates != 2 {
+		t.Errorf("Expected 1 login and 2 create requests, got %d logins and %d creates", logins, creates)
+	}
+
+	if client.sessionID != "fresh" {
+		t.Errorf("Expected the renewed session to be kept, got %q", client.sessionID)
+	}
+}
+
+func TestClient_AutoReauth_FollowsLoginRedirect(t *testing.T) {
+	var logins, creates int
+	server := newExpiringBGA(t, &logins, &creates)
+	defer server.Close()
+
+	client := NewClient("user", "pass", WithBaseURL(server.URL), WithRateLimit(0), WithAutoReauth())
+	if err := client.setSessionCookie("stale"); err != nil {
+		t.Fatalf("Failed to set session cookie: %v", err)
+	}
+
+	status, err := client.GetTournamentStatus(context.Background(), 424242)
+	if err != nil {
+		t.Fatalf("Expected status after re-login, got: %v", err)
+	}
+
+	if status.ID != 424242 || logins != 1 {
+		t.Errorf("Expected status of 424242 after one login, got ID %d with %d logins", status.ID, logins)
+	}
+}
+
+func TestClient_AutoReauth_DisabledByDefault(t *testing.T) {
+	var logins, creates int
+	server := newExpiringBGA(t, &logins, &creates)
+	defer server.Close()
+
+	client := NewClient("user", "pass", WithBaseURL(server.URL), WithRateLimit(0))
+	if err := client.setSessionCookie("stale"); err != nil {
+		t.Fatalf("Failed to set session cookie: %v", err)
+	}
+
+	resp, err := client.CreateSwissTournament(context.Background(), "Elite", "herchu", "webbi", 1, 15)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if resp.Success {
+		t.Error("Expected the creation to fail without auto re-authentication")
+	}
+
+	if logins != 0 || creates != 1 {
+		t.Errorf("Expected no login and a single create, got %d logins and %d creates", logins, creates)
+	}
+}
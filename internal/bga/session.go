@@ -0,0 +1,102 @@
+package bga
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// sessionLifetime is how long a BGA session is trusted after login
+const sessionLifetime = 24 * time.Hour
+
+// ErrSessionExpired is returned when a persisted session is too old to reuse
+var ErrSessionExpired = errors.New("session expired")
+
+// persistedSession is the on-disk representation of a BGA session
+type persistedSession struct {
+	ExpiresAt time.Time `json:"expires_at"`
+	SessionID string    `json:"session_id"`
+}
+
+// DefaultSessionPath returns the session file location under the user config dir
+func DefaultSessionPath() (string, error) {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate user config dir: %w", err)
+	}
+
+	return filepath.Join(configDir, "carca", "session.json"), nil
+}
+
+// SaveSession writes the current session cookie and its expiry to path
+func (c *Client) SaveSession(path string) error {
+	if !c.IsAuthenticated() {
+		return ErrNotAuthenticated
+	}
+
+	data, err := json.Marshal(persistedSession{
+		SessionID: c.sessionID,
+		ExpiresAt: c.sessionExpiry,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode session: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("failed to create session dir: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write session file: %w", err)
+	}
+
+	c.sessionPath = path
+	return nil
+}
+
+// LoadSession restores a session saved by SaveSession, failing with ErrSessionExpired if it is too old
+func (c *Client) LoadSession(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read session file: %w", err)
+	}
+
+	var session persistedSession
+	if err := json.Unmarshal(data, &session); err != nil {
+		return fmt.Errorf("failed to decode session file: %w", err)
+	}
+
+	if session.SessionID == "" || !now().Before(session.ExpiresAt) {
+		return ErrSessionExpired
+	}
+
+	if err := c.setSessionCookie(session.SessionID); err != nil {
+		return err
+	}
+
+	c.sessionExpiry = session.ExpiresAt
+	c.sessionPath = path
+	return nil
+}
+
+// IsSessionExpired reports whether the client has no session or its session is past its expiry
+func (c *Client) IsSessionExpired() bool {
+	return !c.IsAuthenticated() || !now().Before(c.sessionExpiry)
+}
+
+// removeSessionFile deletes the persisted session, if any
+func (c *Client) removeSessionFile() error {
+	if c.sessionPath == "" {
+		return nil
+	}
+
+	if err := os.Remove(c.sessionPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove session file: %w", err)
+	}
+
+	c.sessionPath = ""
+	return nil
+}
@@ -0,0 +1,111 @@
+package bga
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestClient_SaveAndLoadSession(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "carca", "session.json")
+
+	client := NewClient("user", "pass")
+	if err := client.setSessionCookie("abc123"); err != nil {
+		t.Fatalf("Failed to set session cookie: %v", err)
+	}
+	client.sessionExpiry = time.Now().Add(time.Hour)
+
+	if err := client.SaveSession(path); err != nil {
+		t.Fatalf("Failed to save session: %v", err)
+	}
+
+	transport := &flakyTransport{failures: 1}
+	restored := NewClient("user", "pass")
+	restored.httpClient.Transport = transport
+
+	if err := restored.LoadSession(path); err != nil {
+		t.Fatalf("Failed to load session: %v", err)
+	}
+
+	if !restored.IsAuthenticated() {
+		t.Error("Expected restored client to be authenticated")
+	}
+
+	if restored.IsSessionExpired() {
+		t.Error("Expected restored session not to be expired")
+	}
+
+	if restored.sessionID != "abc123" {
+		t.Errorf("Expected session ID 'abc123', got %q", restored.sessionID)
+	}
+
+	if transport.calls != 0 {
+		t.Errorf("Expected no network calls when restoring a session, got %d", transport.calls)
+	}
+}
+
+func TestClient_LoadSession_Expired(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "session.json")
+
+	client := NewClient("user", "pass")
+	if err := client.setSessionCookie("abc123"); err != nil {
+		t.Fatalf("Failed to set session cookie: %v", err)
+	}
+	client.sessionExpiry = time.Now().Add(-time.Minute)
+
+	if err := client.SaveSession(path); err != nil {
+		t.Fatalf("Failed to save session: %v", err)
+	}
+
+	restored := NewClient("user", "pass")
+	if err := restored.LoadSession(path); !errors.Is(err, ErrSessionExpired) {
+		t.Fatalf("Expected ErrSessionExpired, got %v", err)
+	}
+
+	if restored.IsAuthenticated() {
+		t.Error("Expected client with expired session to stay unauthenticated")
+	}
+}
+
+func TestClient_LoadSession_MissingFile(t *testing.T) {
+	client := NewClient("user", "pass")
+	if err := client.LoadSession(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Fatal("Expected error for a missing session file")
+	}
+
+	if !client.IsSessionExpired() {
+		t.Error("Expected client without session to report it as expired")
+	}
+}
+
+func TestClient_Logout_RemovesSessionFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "session.json")
+
+	transport := &flakyTransport{
+		respond: func(req *http.Request) *http.Response {
+			return stubResponse(req, http.StatusOK, "")
+		},
+	}
+
+	client := NewClient("user", "pass")
+	client.httpClient.Transport = transport
+	if err := client.setSessionCookie("abc123"); err != nil {
+		t.Fatalf("Failed to set session cookie: %v", err)
+	}
+	client.sessionExpiry = time.Now().Add(time.Hour)
+
+	if err := client.SaveSession(path); err != nil {
+		t.Fatalf("Failed to save session: %v", err)
+	}
+
+	if err := client.Logout(); err != nil {
+		t.Fatalf("Failed to logout: %v", err)
+	}
+
+	if _, err := os.Stat(path); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("Expected session file to be removed, stat error: %v", err)
+	}
+}
@@ -0,0 +1,267 @@
+package bga
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Default Swiss duel settings: best-of-3 with 30 minute games in the first season
+const (
+	DefaultMatchCount   = 3
+	DefaultGameDuration = 1800
+	DefaultSeason       = 1
+)
+
+// seasonOrdinals holds the Spanish feminine ordinal suffix used for each season number
+var seasonOrdinals = map[int]string{
+	1: "era", 2: "da", 3: "era", 4: "ta", 5: "ta", 6: "ta", 7: "ma", 8: "va", 9: "na", 10: "ma",
+}
+
+// FormatChampionshipName names the championship of a division, e.g. "Division Elite - 2da Temporada"
+// Seasons below 1 are treated as the first season
+func FormatChampionshipName(division string, season int) string {
+	if season < 1 {
+		season = DefaultSeason
+	}
+
+	suffix, ok := seasonOrdinals[season]
+	if !ok {
+		suffix = "°"
+	}
+
+	return fmt.Sprintf("Division %s - %d%s Temporada", division, season, suffix)
+}
+
+// Expansion is a Carcassonne expansion that can be enabled for a tournament
+type Expansion int
+
+const (
+	// ExpansionRiver adds the River starting tiles
+	ExpansionRiver Expansion = iota + 1
+	// ExpansionInnsCathedrals adds Inns & Cathedrals
+	ExpansionInnsCathedrals
+)
+
+// expansionGameOptions maps each expansion to its BGA game option form key
+var expansionGameOptions = map[Expansion]string{
+	ExpansionRiver:          "gameoption_201",
+	ExpansionInnsCathedrals: "gameoption_206",
+}
+
+// Registration types accepted by BGA's registration_type form field
+const (
+	RegistrationInvitationOnly = "invitation_only"
+	RegistrationOpen           = "open"
+	RegistrationGroup          = "group"
+)
+
+// registrationTypes lists every registration type BGA accepts, in the order shown in its form
+var registrationTypes = []string{RegistrationOpen, RegistrationInvitationOnly, RegistrationGroup}
+
+// validateRegistrationType rejects a registration type BGA would not accept
+func validateRegistrationType(registrationType string) error {
+	if slices.Contains(registrationTypes, registrationType) {
+		return nil
+	}
+
+	return fmt.Errorf("invalid registration type %q, expected one of %s",
+		registrationType, strings.Join(registrationTypes, ", "))
+}
+
+// registrationType returns the configured registration type, invitation only when unset
+func (config *TournamentConfig) registrationType() string {
+	if config.RegistrationType == "" {
+		return RegistrationInvitationOnly
+	}
+
+	return config.RegistrationType
+}
+
+// Preset bundles the settings of a kind of league tournament
+type Preset struct {
+	Name             string
+	RegistrationType string
+	MatchCount       int
+	GameDuration     int
+}
+
+var (
+	// PresetRegular is a league Duelo, only the two invited players can join
+	PresetRegular = Preset{
+		Name:             "Regular",
+		RegistrationType: RegistrationInvitationOnly,
+		MatchCount:       DefaultMatchCount,
+		GameDuration:     DefaultGameDuration,
+	}
+	// PresetFriendly is an unofficial match anyone can register for
+	PresetFriendly = Preset{
+		Name:             "Friendly",
+		RegistrationType: RegistrationOpen,
+		MatchCount:       DefaultMatchCount,
+		GameDuration:     DefaultGameDuration,
+	}
+)
+
+// presets lists the known presets for PresetByName
+var presets = []Preset{PresetRegular, PresetFriendly}
+
+// PresetByName finds a preset by name, ignoring case
+func PresetByName(name string) (Preset, bool) {
+	for _, preset := range presets {
+		if strings.EqualFold(preset.Name, strings.TrimSpace(name)) {
+			return preset, true
+		}
+	}
+
+	return Preset{}, false
+}
+
+// StageType is the BGA tournament stage format
+type StageType int
+
+const (
+	// StageSwissV2 is BGA's Swiss system, the default for league duels
+	StageSwissV2 StageType = iota
+	// StageSingleElimination is a knockout bracket
+	StageSingleElimination
+)
+
+// stageTypeFormValues maps each stage type to its BGA stage_type form value
+var stageTypeFormValues = map[StageType]string{
+	StageSwissV2:           "swissSystemV2",
+	StageSingleElimination: "simple",
+}
+
+// formValue returns the stage_type form value, falling back to Swiss for unknown stage types
+func (s StageType) formValue() string {
+	if value, ok := stageTypeFormValues[s]; ok {
+		return value
+	}
+
+	return stageTypeFormValues[StageSwissV2]
+}
+
+// TournamentOption customizes the configuration of a Swiss duel tournament
+type TournamentOption func(*TournamentConfig)
+
+// WithMatchCount sets the number of games in the duel, e.g. 5 for best-of-5
+func WithMatchCount(n int) TournamentOption {
+	return func(config *TournamentConfig) {
+		config.MatchesCount = n
+	}
+}
+
+// WithGameDuration sets the maximum game duration in seconds
+func WithGameDuration(seconds int) TournamentOption {
+	return func(config *TournamentConfig) {
+		config.GameDuration = seconds
+	}
+}
+
+// WithExpansions enables the given Carcassonne expansions
+func WithExpansions(expansions ...Expansion) TournamentOption {
+	return func(config *TournamentConfig) {
+		config.Expansions = append(config.Expansions, expansions...)
+	}
+}
+
+// WithSeason names the championship after the given season instead of the first one
+func WithSeason(season int) TournamentOption {
+	return func(config *TournamentConfig) {
+		config.Season = season
+		config.ChampionshipName = FormatChampionshipName(config.Division, season)
+	}
+}
+
+// WithRegistrationType sets who can register, invitation only unless given
+// The value is checked against BGA's registration types when the tournament is created
+func WithRegistrationType(registrationType string) TournamentOption {
+	return func(config *TournamentConfig) {
+		config.RegistrationType = registrationType
+	}
+}
+
+// WithPreset applies the registration type, duel length and game duration of a preset
+func WithPreset(preset Preset) TournamentOption {
+	return func(config *TournamentConfig) {
+		config.RegistrationType = preset.RegistrationType
+		config.MatchesCount = preset.MatchCount
+		config.GameDuration = preset.GameDuration
+	}
+}
+
+// WithStageType sets the tournament stage format, Swiss unless given
+func WithStageType(stageType StageType) TournamentOption {
+	return func(config *TournamentConfig) {
+		config.StageType = stageType
+	}
+}
+
+// newSwissTournamentConfig builds the configuration of a two-player Swiss duel between home and away
+func newSwissTournamentConfig(
+	division, homePlayer, awayPlayer string,
+	roundNumber, matchNumber int,
+	baseDate, baseDateTime string,
+	opts ...TournamentOption,
+) *TournamentConfig {
+	config := &TournamentConfig{
+		GameID:           1, // Carcassonne game ID
+		ChampionshipName: FormatChampionshipName(division, DefaultSeason),
+		TournamentName:   fmt.Sprintf("%d Fecha - Duelo %d - %s vs %s", roundNumber, matchNumber, homePlayer, awayPlayer),
+		MaxPlayers:       2,
+		MinPlayers:       2,
+		BaseDate:         baseDate,
+		BaseDateTime:     baseDateTime,
+		GameDuration:     DefaultGameDuration,
+		MatchesCount:     DefaultMatchCount,
+		RegistrationType: RegistrationInvitationOnly,
+		Division:         division,
+		RoundNumber:      roundNumber,
+		MatchNumber:      matchNumber,
+		Season:           DefaultSeason,
+		LocalPlayer:      homePlayer,
+		VisitorPlayer:    awayPlayer,
+	}
+
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	return config
+}
+
+// newKnockoutTournamentConfig builds the configuration of a single-elimination bracket for up to maxPlayers
+func newKnockoutTournamentConfig(
+	division, tournamentName string,
+	maxPlayers int,
+	baseDate, baseDateTime string,
+	opts ...TournamentOption,
+) *TournamentConfig {
+	config := &TournamentConfig{
+		GameID:           1, // Carcassonne game ID
+		ChampionshipName: FormatChampionshipName(division, DefaultSeason),
+		TournamentName:   tournamentName,
+		MaxPlayers:       maxPlayers,
+		MinPlayers:       2,
+		BaseDate:         baseDate,
+		BaseDateTime:     baseDateTime,
+		GameDuration:     DefaultGameDuration,
+		MatchesCount:     DefaultMatchCount,
+		RegistrationType: RegistrationInvitationOnly,
+		Division:         division,
+		Season:           DefaultSeason,
+		StageType:        StageSingleElimination,
+	}
+
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	return config
+}
+
+// defaultSchedule returns today's date at 21:00, used when no datetime is given
+func defaultSchedule() (baseDate, baseDateTime string) {
+	return now().Format("2006-01-02"), "21:00"
+}
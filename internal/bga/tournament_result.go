@@ -0,0 +1,66 @@
+package bga
+
+import (
+	"context"
+	"fmt"
+)
+
+// GetTournamentResult tells who won the Duelo and the games each player took
+func (c *Client) GetTournamentResult(
+	ctx context.Context,
+	tournamentID int,
+) (winner string, homeWins, awayWins int, err error) {
+	status, err := c.GetTournamentStatus(ctx, tournamentID)
+	if err != nil {
+		return "", 0, 0, err
+	}
+
+	return duelResult(status)
+}
+
+// DuelScore counts the finished games each player of the duel has won so far
+func (s *TournamentStatus) DuelScore() (homeWins, awayWins int) {
+	homePlayer, awayPlayer := s.duelPlayers()
+
+	for _, match := range s.Matches {
+		if match.Status != "finished" {
+			continue
+		}
+
+		switch match.Winner {
+		case homePlayer:
+			homeWins++
+		case awayPlayer:
+			awayWins++
+		}
+	}
+
+	return homeWins, awayWins
+}
+
+// duelPlayers returns the home and away players of the duel, as seated at its first game table
+func (s *TournamentStatus) duelPlayers() (homePlayer, awayPlayer string) {
+	if len(s.Matches) == 0 {
+		return "", ""
+	}
+
+	return s.Matches[0].HomePlayer, s.Matches[0].AwayPlayer
+}
+
+// duelResult counts the finished games of a duel and names its winner
+// A duel is decided once the tournament finished or a player took the majority of its games
+func duelResult(status *TournamentStatus) (winner string, homeWins, awayWins int, err error) {
+	homePlayer, awayPlayer := status.duelPlayers()
+	homeWins, awayWins = status.DuelScore()
+
+	majority := len(status.Matches)/2 + 1
+
+	switch {
+	case homeWins >= majority || (status.Status == "finished" && homeWins > awayWins):
+		return homePlayer, homeWins, awayWins, nil
+	case awayWins >= majority || (status.Status == "finished" && awayWins > homeWins):
+		return awayPlayer, homeWins, awayWins, nil
+	default:
+		return "", homeWins, awayWins, fmt.Errorf("%w: tournament %d", ErrTournamentNotFinished, status.ID)
+	}
+}
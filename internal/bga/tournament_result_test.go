@@ -0,0 +1,114 @@
+package bga
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+)
+
+func TestMockClient_GetTournamentResult(t *testing.T) {
+	ctx := context.Background()
+	mockClient := NewMockClient("testuser", "testpass")
+	if err := mockClient.Login(ctx); err != nil {
+		t.Fatalf("Failed to login: %v", err)
+	}
+
+	resp, err := mockClient.CreateSwissTournament(ctx, "Elite", "herchu", "webbi", 1, 1)
+	if err != nil {
+		t.Fatalf("Failed to create tournament: %v", err)
+	}
+
+	if err := mockClient.SimulateMatchResult(resp.TournamentID, 1, 112, 98, "herchu"); err != nil {
+		t.Fatalf("Failed to simulate first game: %v", err)
+	}
+
+	if _, _, _, err := mockClient.GetTournamentResult(ctx, resp.TournamentID); !errors.Is(err, ErrTournamentNotFinished) {
+		t.Errorf("Expected ErrTournamentNotFinished after a single game, got %v", err)
+	}
+
+	if err := mockClient.SimulateMatchResult(resp.TournamentID, 2, 87, 80, "herchu"); err != nil {
+		t.Fatalf("Failed to simulate second game: %v", err)
+	}
+
+	winner, homeWins, awayWins, err := mockClient.GetTournamentResult(ctx, resp.TournamentID)
+	if err != nil {
+		t.Fatalf("Expected a decided duel, got %v", err)
+	}
+
+	if winner != "herchu" {
+		t.Errorf("Expected herchu to win the Duelo, got %q", winner)
+	}
+
+	if homeWins != 2 || awayWins != 0 {
+		t.Errorf("Expected a 2-0 tally, got %d-%d", homeWins, awayWins)
+	}
+}
+
+func TestDuelResult_AwayWinsFinishedTournament(t *testing.T) {
+	status := &TournamentStatus{
+		ID:     7,
+		Status: "finished",
+		Matches: []MatchStatus{
+			{Status: "finished", HomePlayer: "herchu", AwayPlayer: "webbi", Winner: "webbi"},
+			{Status: "finished", HomePlayer: "webbi", AwayPlayer: "herchu", Winner: "herchu"},
+			{Status: "finished", HomePlayer: "herchu", AwayPlayer: "webbi", Winner: "webbi"},
+		},
+	}
+
+	winner, homeWins, awayWins, err := duelResult(status)
+	if err != nil {
+		t.Fatalf("Expected a decided duel, got %v", err)
+	}
+
+	if winner != "webbi" || homeWins != 1 || awayWins != 2 {
+		t.Errorf("Expected webbi to win 1-2, got %q %d-%d", winner, homeWins, awayWins)
+	}
+}
+
+func TestTournamentStatus_DuelScore(t *testing.T) {
+	status := &TournamentStatus{
+		Status: "in_progress",
+		Matches: []MatchStatus{
+			{Status: "finished", HomePlayer: "herchu", AwayPlayer: "webbi", Winner: "herchu"},
+			{Status: "in_progress", HomePlayer: "herchu", AwayPlayer: "webbi"},
+			{Status: "waiting", HomePlayer: "herchu", AwayPlayer: "webbi"},
+		},
+	}
+
+	if homeWins, awayWins := status.DuelScore(); homeWins != 1 || awayWins != 0 {
+		t.Errorf("Expected 1-0 so far, got %d-%d", homeWins, awayWins)
+	}
+
+	if homeWins, awayWins := (&TournamentStatus{}).DuelScore(); homeWins != 0 || awayWins != 0 {
+		t.Errorf("Expected 0-0 without game tables, got %d-%d", homeWins, awayWins)
+	}
+}
+
+func TestClient_GetTournamentResult_NotFinished(t *testing.T) {
+	fixture, err := os.ReadFile("testdata/tournament_status.json")
+	if err != nil {
+		t.Fatalf("Failed to read fixture: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(fixture)
+	}))
+	defer server.Close()
+
+	client := NewClient("user", "pass", WithBaseURL(server.URL), WithRateLimit(0))
+	if err := client.setSessionCookie("test-session-id"); err != nil {
+		t.Fatalf("Failed to set session cookie: %v", err)
+	}
+
+	_, homeWins, awayWins, err := client.GetTournamentResult(context.Background(), 423762)
+	if !errors.Is(err, ErrTournamentNotFinished) {
+		t.Fatalf("Expected ErrTournamentNotFinished, got %v", err)
+	}
+
+	if homeWins != 1 || awayWins != 0 {
+		t.Errorf("Expected the partial 1-0 tally, got %d-%d", homeWins, awayWins)
+	}
+}
@@ -0,0 +1,182 @@
+package bga
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// tournamentStatusResponse is the envelope BGA wraps around tournamentStatus.html replies
+type tournamentStatusResponse struct {
+	Data struct {
+		ID         json.Number          `json:"id"`
+		Name       string               `json:"name"`
+		State      string               `json:"status"`
+		PlayersNbr json.Number          `json:"players_nbr"`
+		Results    json.RawMessage      `json:"results"`
+		Tables     []tournamentTableRow `json:"tables"`
+	} `json:"data"`
+	ajaxStatusResponse
+}
+
+// tournamentTableRow is a single game table as listed in BGA's tournament status
+type tournamentTableRow struct {
+	ID           json.Number `json:"id"`
+	State        string      `json:"status"`
+	Player1      string      `json:"player1_name"`
+	Player2      string      `json:"player2_name"`
+	Player1Score json.Number `json:"player1_score"`
+	Player2Score json.Number `json:"player2_score"`
+	Winner       string      `json:"winner_name"`
+}
+
+// tournamentResultRow is one entry of the results array form BGA uses for launched tournaments
+type tournamentResultRow struct {
+	Player string      `json:"player_name"`
+	Score  json.Number `json:"score"`
+}
+
+// tournamentStates maps BGA's tournament and table states onto the ones TournamentStatus uses
+var tournamentStates = map[string]string{
+	"open":       "waiting",
+	"waiting":    "waiting",
+	"underway":   "in_progress",
+	"inprogress": "in_progress",
+	"play":       "in_progress",
+	"finished":   "finished",
+	"closed":     "finished",
+}
+
+// parseTournamentStatus decodes a tournamentStatus.html body into a TournamentStatus
+func parseTournamentStatus(body []byte) (*TournamentStatus, error) {
+	var resp tournamentStatusResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse tournament status: %w", err)
+	}
+
+	if !resp.succeeded() {
+		return nil, fmt.Errorf("tournament status request rejected: %s", resp.rejectionReason("unknown error"))
+	}
+
+	data := resp.Data
+
+	id, err := atoiNumber(data.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tournament id %q: %w", data.ID, err)
+	}
+
+	playersCount, err := atoiNumber(data.PlayersNbr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid players count %q: %w", data.PlayersNbr, err)
+	}
+
+	results, err := parseTournamentResults(data.Results)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := make([]MatchStatus, 0, len(data.Tables))
+	for _, table := range data.Tables {
+		match, err := table.toMatchStatus()
+		if err != nil {
+			return nil, err
+		}
+		matches = append(matches, match)
+	}
+
+	return &TournamentStatus{
+		ID:           id,
+		Name:         data.Name,
+		Status:       normalizeTournamentState(data.State),
+		PlayersCount: playersCount,
+		Results:      results,
+		Matches:      matches,
+	}, nil
+}
+
+// parseTournamentResults accepts results as either a player -> score object or an array of rows
+func parseTournamentResults(raw json.RawMessage) (map[string]int, error) {
+	results := make(map[string]int)
+
+	trimmed := strings.TrimSpace(string(raw))
+	if trimmed == "" || trimmed == "null" {
+		return results, nil
+	}
+
+	if strings.HasPrefix(trimmed, "[") {
+		var rows []tournamentResultRow
+		if err := json.Unmarshal(raw, &rows); err != nil {
+			return nil, fmt.Errorf("failed to parse tournament results: %w", err)
+		}
+
+		for _, row := range rows {
+			score, err := atoiNumber(row.Score)
+			if err != nil {
+				return nil, fmt.Errorf("invalid score %q for %s: %w", row.Score, row.Player, err)
+			}
+			results[row.Player] = score
+		}
+
+		return results, nil
+	}
+
+	var scores map[string]json.Number
+	if err := json.Unmarshal(raw, &scores); err != nil {
+		return nil, fmt.Errorf("failed to parse tournament results: %w", err)
+	}
+
+	for player, value := range scores {
+		score, err := atoiNumber(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid score %q for %s: %w", value, player, err)
+		}
+		results[player] = score
+	}
+
+	return results, nil
+}
+
+// toMatchStatus converts a BGA game table row into a MatchStatus
+func (t *tournamentTableRow) toMatchStatus() (MatchStatus, error) {
+	id, err := atoiNumber(t.ID)
+	if err != nil {
+		return MatchStatus{}, fmt.Errorf("invalid game table id %q: %w", t.ID, err)
+	}
+
+	homeScore, err := atoiNumber(t.Player1Score)
+	if err != nil {
+		return MatchStatus{}, fmt.Errorf("invalid score %q on table %d: %w", t.Player1Score, id, err)
+	}
+
+	awayScore, err := atoiNumber(t.Player2Score)
+	if err != nil {
+		return MatchStatus{}, fmt.Errorf("invalid score %q on table %d: %w", t.Player2Score, id, err)
+	}
+
+	return MatchStatus{
+		ID:         id,
+		Status:     normalizeTournamentState(t.State),
+		HomePlayer: t.Player1,
+		AwayPlayer: t.Player2,
+		HomeScore:  homeScore,
+		AwayScore:  awayScore,
+		Winner:     t.Winner,
+	}, nil
+}
+
+// normalizeTournamentState maps a BGA state onto ours, keeping unknown states as sent
+func normalizeTournamentState(state string) string {
+	if mapped, ok := tournamentStates[strings.ToLower(state)]; ok {
+		return mapped
+	}
+	return state
+}
+
+// atoiNumber converts a BGA number, sent either quoted or bare, treating a missing value as zero
+func atoiNumber(n json.Number) (int, error) {
+	if n == "" {
+		return 0, nil
+	}
+	return strconv.Atoi(string(n))
+}
@@ -1,6 +1,9 @@
 package cli
 
 import (
+	"fmt"
+	"time"
+
 	tea "github.com/charmbracelet/bubbletea"
 
 	"carca-cli/internal/bga"
@@ -14,6 +17,8 @@ const (
 	ScreenMenu Screen = iota
 	ScreenDivisionSelect
 	ScreenFixture
+	ScreenStandings
+	ScreenCreateTournament
 )
 
 // ViewFixtureSelectMsg is sent when user selects "View Fixture" from main menu
@@ -21,20 +26,73 @@ type ViewFixtureSelectMsg struct{}
 
 // AppModel coordinates navigation between different screens
 type AppModel struct {
-	menuModel     *MenuModel
-	divisionModel *DivisionModel
-	fixtureModel  *FixtureModel
-	currentScreen Screen
+	menuModel        *MenuModel
+	divisionModel    *DivisionModel
+	fixtureModel     *FixtureModel
+	standingsModel   *StandingsModel
+	createModel      *CreateTournamentModel
+	bgaClient        bga.APIClient
+	currentScreen    Screen
+	divisionTarget   Screen
+	statusClearDelay time.Duration
+	markers          StatusMarkers
+	parseOptions     []fixtures.ParseOption
+	tipsStatePath    string
+	width            int
+	height           int
+	log              []LogEntry
+	logScroll        int
+	showLog          bool
+	showTips         bool
+	helpCollapsed    bool
+	use24Hour        bool
+	isoDates         bool
+	homePending      bool
 }
 
 // NewAppModel creates a new app coordinator model
 func NewAppModel() *AppModel {
 	return &AppModel{
-		currentScreen: ScreenMenu,
-		menuModel:     NewMenuModel(),
+		currentScreen:    ScreenMenu,
+		menuModel:        NewMenuModel(),
+		statusClearDelay: DefaultStatusClearDelay,
+		markers:          DefaultStatusMarkers,
 	}
 }
 
+// SetBGAClient sets the BGA client the fixture screens opened from now on create tournaments with
+// Without one they use a mock client, so nothing reaches BGA
+func (m *AppModel) SetBGAClient(client bga.APIClient) {
+	m.bgaClient = client
+}
+
+// SetParseOptions sets how the fixture files opened from now on are read, e.g. their forfeit sentinel
+func (m *AppModel) SetParseOptions(opts ...fixtures.ParseOption) {
+	m.parseOptions = opts
+}
+
+// SetStatusClearDelay sets how long status messages stay on screen in the screens opened from now on
+func (m *AppModel) SetStatusClearDelay(delay time.Duration) {
+	if delay > 0 {
+		m.statusClearDelay = delay
+	}
+}
+
+// SetUse24Hour chooses 24-hour time display for the fixture screens opened from now on
+func (m *AppModel) SetUse24Hour(use24Hour bool) {
+	m.use24Hour = use24Hour
+}
+
+// SetStatusMarkers sets the PLAYED column symbols of the fixture screens opened from now on
+func (m *AppModel) SetStatusMarkers(markers StatusMarkers) {
+	m.markers = markers
+}
+
+// SetISODates chooses ISO dates in the fixture tables opened from now on
+func (m *AppModel) SetISODates(isoDates bool) {
+	m.isoDates = isoDates
+}
+
 // Init initializes the app model (required by Bubble Tea)
 func (m *AppModel) Init() tea.Cmd {
 	return nil
@@ -42,43 +100,93 @@ func (m *AppModel) Init() tea.Cmd {
 
 // Update handles messages and manages screen transitions
 func (m *AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	m.recordEvent(msg)
+
+	// Remember the terminal size for screens opened later, the current one still gets the message below
+	if size, ok := msg.(tea.WindowSizeMsg); ok {
+		m.width, m.height = size.Width, size.Height
+	}
+
+	if keyMsg, ok := msg.(tea.KeyMsg); ok {
+		if m.showTips {
+			return m.updateTips(keyMsg)
+		}
+		if m.showLog {
+			return m.updateLog(keyMsg)
+		}
+		if keyMsg.String() == "L" && m.canOpenLog() {
+			m.openLog()
+			return m, nil
+		}
+		if keyMsg.String() == homeKey && m.canGoHome() {
+			return m.goHome()
+		}
+		m.homePending = false
+	}
+
 	switch msg := msg.(type) {
 	case ViewFixtureSelectMsg:
 		// Transition from menu to division selection
 		m.currentScreen = ScreenDivisionSelect
-		m.divisionModel = NewDivisionModel()
+		m.divisionTarget = ScreenFixture
+		m.divisionModel = NewDivisionModel(m.parseOptions...)
+
+		return m, nil
+
+	case CreateTournamentSelectMsg:
+		// Same division selection, leading to the unplayed matches instead
+		m.currentScreen = ScreenDivisionSelect
+		m.divisionTarget = ScreenCreateTournament
+		m.divisionModel = NewDivisionModel(m.parseOptions...)
+
+		return m, nil
+
+	case ViewPositionsSelectMsg:
+		// Same division selection, leading to the standings instead
+		m.currentScreen = ScreenDivisionSelect
+		m.divisionTarget = ScreenStandings
+		m.divisionModel = NewDivisionModel(m.parseOptions...)
 
 		return m, nil
 
 	case DivisionSelectMsg:
-		// Transition from division selection to fixture display
-		m.currentScreen = ScreenFixture
+		division, problems := m.loadDivision(msg)
+
+		if m.divisionTarget == ScreenStandings {
+			m.currentScreen = ScreenStandings
+			m.standingsModel = NewStandingsModel(division)
+			m.standingsModel.SetSize(m.width, m.height)
+			m.standingsModel.SetStatusClearDelay(m.statusClearDelay)
 
-		// Load fixture data
-		division, err := fixtures.ParseFixtureFile(msg.Filename)
-		if err != nil {
-			// If loading fails, show error in fixture model
-			m.fixtureModel = NewFixtureModel(&fixtures.Division{
-				Name:   msg.Division,
-				Rounds: []*fixtures.Round{},
-			})
-		} else {
-			m.fixtureModel = NewFixtureModel(division)
+			return m, nil
 		}
 
-		// Set up BGA client with mock client for now
-		// In production, this would be a real client
-		mockClient := bga.NewMockClient("", "")
-		m.fixtureModel.SetBGAClient(mockClient)
+		if m.divisionTarget == ScreenCreateTournament {
+			m.currentScreen = ScreenCreateTournament
+			m.createModel = NewCreateTournamentModel(m.newFixtureModel(division))
+
+			return m, nil
+		}
+
+		// Transition from division selection to fixture display
+		m.currentScreen = ScreenFixture
+		m.fixtureModel = m.newFixtureModel(division)
+		m.fixtureModel.SetValidationProblems(problems)
 
 		return m, nil
 
 	case BackToMenuMsg:
 		// Go back to main menu from any screen
 		m.currentScreen = ScreenMenu
+		// Keep the help footer as the user left it for the rest of the session
+		if m.fixtureModel != nil {
+			m.helpCollapsed = m.fixtureModel.helpCollapsed
+		}
 		// Clear other models to free memory
 		m.divisionModel = nil
 		m.fixtureModel = nil
+		m.standingsModel = nil
+		m.createModel = nil
 
 		return m, nil
 
@@ -92,11 +200,16 @@ func (m *AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					m.menuModel = menuModel
 				}
 
-				// Check if user selected "View Fixture"
-				if cmd != nil && m.menuModel.GetSelectedChoice() == "View Fixture" {
-					if keyMsg, ok := msg.(tea.KeyMsg); ok && keyMsg.Type == tea.KeyEnter {
+				// Check if user selected one of the menu screens
+				if keyMsg, ok := msg.(tea.KeyMsg); ok && cmd != nil && keyMsg.Type == tea.KeyEnter {
+					switch m.menuModel.GetSelectedChoice() {
+					case "Create Tournament":
+						return m.Update(CreateTournamentSelectMsg{})
+					case "View Fixture":
 						// Trigger transition to division selection
 						return m.Update(ViewFixtureSelectMsg{})
+					case "View Positions":
+						return m.Update(ViewPositionsSelectMsg{})
 					}
 				}
 
@@ -120,6 +233,26 @@ func (m *AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					m.fixtureModel = fixModel
 				}
 
+				return m, cmd
+			}
+
+		case ScreenStandings:
+			if m.standingsModel != nil {
+				updatedModel, cmd := m.standingsModel.Update(msg)
+				if standingsModel, ok := updatedModel.(*StandingsModel); ok {
+					m.standingsModel = standingsModel
+				}
+
+				return m, cmd
+			}
+
+		case ScreenCreateTournament:
+			if m.createModel != nil {
+				updatedModel, cmd := m.createModel.Update(msg)
+				if createModel, ok := updatedModel.(*CreateTournamentModel); ok {
+					m.createModel = createModel
+				}
+
 				return m, cmd
 			}
 		}
@@ -128,8 +261,57 @@ func (m *AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// newFixtureModel creates a fixture model for the division with the app's display settings
+func (m *AppModel) newFixtureModel(division *fixtures.Division) *FixtureModel {
+	fixtureModel := NewFixtureModel(division)
+
+	client := m.bgaClient
+	if client == nil {
+		client = bga.NewMockClient("", "")
+	}
+	fixtureModel.SetBGAClient(client)
+	fixtureModel.SetStatusClearDelay(m.statusClearDelay)
+	fixtureModel.SetUse24Hour(m.use24Hour)
+	fixtureModel.SetISODates(m.isoDates)
+	fixtureModel.SetStatusMarkers(m.markers)
+	fixtureModel.SetSize(m.width, m.height)
+	fixtureModel.SetHelpCollapsed(m.helpCollapsed)
+
+	return fixtureModel
+}
+
+// loadDivision parses the selected division's fixture, falling back to an empty division on error
+// The problems found by fixtures.ValidateDivision are logged and returned for the fixture screen to warn about
+func (m *AppModel) loadDivision(msg DivisionSelectMsg) (*fixtures.Division, []error) {
+	division, err := fixtures.ParseFixtureFile(msg.Filename, m.parseOptions...)
+	if err != nil {
+		m.appendLog(fmt.Sprintf("Failed to load fixture for %s: %v", msg.Division, err), true)
+
+		// If loading fails, show an empty division
+		return &fixtures.Division{
+			Name:   msg.Division,
+			Rounds: []*fixtures.Round{},
+		}, nil
+	}
+
+	problems := fixtures.ValidateDivision(division)
+	for _, problem := range problems {
+		m.appendLog(fmt.Sprintf("Fixture problem in %s: %v", msg.Division, problem), true)
+	}
+
+	return division, problems
+}
+
 // View renders the current screen
 func (m *AppModel) View() string {
+	if m.showTips {
+		return m.renderTips()
+	}
+
+	if m.showLog {
+		return m.renderLog()
+	}
+
 	switch m.currentScreen {
 	case ScreenMenu:
 		if m.menuModel != nil {
@@ -152,6 +334,20 @@ func (m *AppModel) View() string {
 
 		return "Loading fixture data...\n\nPress esc/q to go back.\n"
 
+	case ScreenStandings:
+		if m.standingsModel != nil {
+			return m.standingsModel.View()
+		}
+
+		return "Loading positions...\n\nPress esc/q to go back.\n"
+
+	case ScreenCreateTournament:
+		if m.createModel != nil {
+			return m.createModel.View()
+		}
+
+		return "Loading unplayed matches...\n\nPress esc/q to go back.\n"
+
 	default:
 		return "Unknown screen\n"
 	}
@@ -1,10 +1,15 @@
 package cli
 
 import (
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 
+	"carca-cli/internal/bga"
 	"carca-cli/internal/fixtures"
 )
 
@@ -77,6 +82,37 @@ func TestAppModel_Update_DivisionToFixture(t *testing.T) {
 	}
 }
 
+func TestAppModel_Update_DivisionToFixture_WarnsAboutProblems(t *testing.T) {
+	data := "Duelo,Fecha 1,,,,11/08 - 17/08,Detalles,,¿Se jugó?,¿Ganó Local?,¿Ganó Visita?,,,\n" +
+		"1,herchu,0,0,Lord Trooper,-,,,0,0,0,,,\n" +
+		"1,,0,0,,-,,,0,0,0,,,\n"
+
+	filename := filepath.Join(t.TempDir(), "Liga Argentina - 1° Temporada - E-Fixture.csv")
+	if err := os.WriteFile(filename, []byte(data), 0o600); err != nil {
+		t.Fatalf("Failed to write fixture: %v", err)
+	}
+
+	model := NewAppModel()
+	model.currentScreen = ScreenDivisionSelect
+	model.divisionTarget = ScreenFixture
+	_, _ = model.Update(DivisionSelectMsg{Division: "Elite", Filename: filename})
+
+	if len(model.fixtureModel.problems) != 2 {
+		t.Fatalf("Expected the duplicate number and the missing player, got %v", model.fixtureModel.problems)
+	}
+
+	view := model.View()
+	for _, want := range []string{"⚠ Fixture problems:", "match 1 appears in round 1 and round 1", "missing player name"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("Expected the banner to contain %q, got:\n%s", want, view)
+		}
+	}
+
+	if len(model.log) != 2 || !model.log[0].IsError {
+		t.Errorf("Expected both problems in the session log, got %+v", model.log)
+	}
+}
+
 func TestAppModel_Update_BackToMenu(t *testing.T) {
 	model := NewAppModel()
 	model.currentScreen = ScreenDivisionSelect
@@ -191,3 +227,85 @@ func TestAppModel_LoadFixtureData_Success(t *testing.T) {
 		t.Error("Expected division to be set correctly in fixture model")
 	}
 }
+
+func TestAppModel_StatusClearDelay_PassedToFixture(t *testing.T) {
+	model := NewAppModel()
+	model.SetStatusClearDelay(5 * time.Second)
+
+	_, _ = model.Update(DivisionSelectMsg{Division: "Elite", Filename: "missing.csv"})
+
+	if model.fixtureModel == nil {
+		t.Fatal("Expected fixture model to be created")
+	}
+	if model.fixtureModel.statusClearDelay != 5*time.Second {
+		t.Errorf("Expected fixture delay 5s, got %v", model.fixtureModel.statusClearDelay)
+	}
+}
+
+func TestAppModel_ParseOptions_UsedForFixtures(t *testing.T) {
+	data := "Duelo,Fecha 1,,,,11/08 - 17/08,Detalles,,¿Se jugó?,¿Ganó Local?,¿Ganó Visita?,,,\n" +
+		"1,herchu,2,-99,Lord Trooper,-,,,1,1,0,,,\n"
+
+	filename := filepath.Join(t.TempDir(), "Liga Argentina - 1° Temporada - E-Fixture.csv")
+	if err := os.WriteFile(filename, []byte(data), 0o600); err != nil {
+		t.Fatalf("Failed to write fixture: %v", err)
+	}
+
+	model := NewAppModel()
+	model.SetParseOptions(fixtures.WithForfeitScore(-99))
+	_, _ = model.Update(DivisionSelectMsg{Division: "Elite", Filename: filename})
+
+	rounds := model.fixtureModel.division.Rounds
+	if len(rounds) != 1 || !rounds[0].Matches[0].AwayForfeited() {
+		t.Errorf("Expected the fixture read with the -99 forfeit sentinel, got %+v", rounds)
+	}
+}
+
+func TestAppModel_BGAClient_PassedToFixture(t *testing.T) {
+	model := NewAppModel()
+	_, _ = model.Update(DivisionSelectMsg{Division: "Elite", Filename: "missing.csv"})
+
+	if _, ok := model.fixtureModel.bgaClient.(*bga.MockClient); !ok {
+		t.Errorf("Expected a mock client without one set, got %T", model.fixtureModel.bgaClient)
+	}
+
+	client := bga.NewClient("user", "pass")
+	model.SetBGAClient(client)
+	_, _ = model.Update(DivisionSelectMsg{Division: "Elite", Filename: "missing.csv"})
+
+	if model.fixtureModel.bgaClient != client {
+		t.Errorf("Expected the fixture to create tournaments with the app's client, got %T", model.fixtureModel.bgaClient)
+	}
+}
+
+func TestAppModel_WindowSize_PassedToNewScreens(t *testing.T) {
+	model := NewAppModel()
+
+	_, _ = model.Update(tea.WindowSizeMsg{Width: 90, Height: 30})
+	_, _ = model.Update(DivisionSelectMsg{Division: "Elite", Filename: "missing.csv"})
+
+	if model.fixtureModel == nil {
+		t.Fatal("Expected fixture model to be created")
+	}
+	if model.fixtureModel.width != 90 || model.fixtureModel.height != 30 {
+		t.Errorf("Expected fixture size 90x30, got %dx%d", model.fixtureModel.width, model.fixtureModel.height)
+	}
+
+	_, _ = model.Update(tea.WindowSizeMsg{Width: 60, Height: 20})
+	if model.fixtureModel.width != 60 {
+		t.Errorf("Expected the open fixture to follow a resize, got width %d", model.fixtureModel.width)
+	}
+}
+
+func TestAppModel_HelpCollapsed_KeptForTheSession(t *testing.T) {
+	model := NewAppModel()
+
+	_, _ = model.Update(DivisionSelectMsg{Division: "Elite", Filename: "missing.csv"})
+	_, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("?")})
+	_, _ = model.Update(BackToMenuMsg{})
+	_, _ = model.Update(DivisionSelectMsg{Division: "Elite", Filename: "missing.csv"})
+
+	if model.fixtureModel == nil || !model.fixtureModel.helpCollapsed {
+		t.Error("Expected the collapsed footer to carry over to the next fixture screen")
+	}
+}
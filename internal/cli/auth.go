@@ -3,6 +3,7 @@ package cli
 import (
 	"bufio"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 )
@@ -20,7 +21,7 @@ func GetBGACredentials() (username, password string, err error) {
 
 	// If not in environment, try to load from .env file
 	envUser, envPass, err := loadFromEnvFile()
-	if err == nil && envUser != "" && envPass != "" {
+	if err == nil {
 		// Use .env values for any missing environment variables
 		if user == "" {
 			user = envUser
@@ -29,15 +30,43 @@ func GetBGACredentials() (username, password string, err error) {
 		if pass == "" {
 			pass = envPass
 		}
+	}
 
-		if user != "" && pass != "" {
-			return user, pass, nil
-		}
+	switch {
+	case user != "" && pass != "":
+		return user, pass, nil
+	case user != "":
+		return "", "", fmt.Errorf("BGA_USER set but BGA_PASS missing")
+	case pass != "":
+		return "", "", fmt.Errorf("BGA_PASS set but BGA_USER missing")
 	}
 
 	return "", "", fmt.Errorf("BGA credentials not found in environment or .env file")
 }
 
+// unquoteEnvValue strips one pair of matching single or double quotes around a .env value
+func unquoteEnvValue(value string) string {
+	if len(value) < 2 {
+		return value
+	}
+
+	first, last := value[0], value[len(value)-1]
+	if first == last && (first == '"' || first == '\'') {
+		return value[1 : len(value)-1]
+	}
+
+	return value
+}
+
+// formatEnvValue quotes a value that loadFromEnvFile would otherwise trim or unquote
+func formatEnvValue(value string) string {
+	if unquoteEnvValue(strings.TrimSpace(value)) == value {
+		return value
+	}
+
+	return `"` + value + `"`
+}
+
 // loadFromEnvFile reads BGA credentials from a .env file
 func loadFromEnvFile() (username, password string, err error) {
 	file, err := os.Open(".env")
@@ -56,13 +85,15 @@ func loadFromEnvFile() (username, password string, err error) {
 			continue
 		}
 
+		line = strings.TrimPrefix(line, "export ")
+
 		parts := strings.SplitN(line, "=", 2)
 		if len(parts) != 2 {
 			continue
 		}
 
 		key := strings.TrimSpace(parts[0])
-		value := strings.TrimSpace(parts[1])
+		value := unquoteEnvValue(strings.TrimSpace(parts[1]))
 
 		switch key {
 		case "BGA_USER":
@@ -93,13 +124,13 @@ func SaveCredentialsToEnv(user, pass string) error {
 
 		for scanner.Scan() {
 			line := scanner.Text()
-			trimmed := strings.TrimSpace(line)
+			trimmed := strings.TrimPrefix(strings.TrimSpace(line), "export ")
 
 			if strings.HasPrefix(trimmed, "BGA_USER=") {
-				lines = append(lines, fmt.Sprintf("BGA_USER=%s", user))
+				lines = append(lines, fmt.Sprintf("BGA_USER=%s", formatEnvValue(user)))
 				foundUser = true
 			} else if strings.HasPrefix(trimmed, "BGA_PASS=") {
-				lines = append(lines, fmt.Sprintf("BGA_PASS=%s", pass))
+				lines = append(lines, fmt.Sprintf("BGA_PASS=%s", formatEnvValue(pass)))
 				foundPass = true
 			} else {
 				lines = append(lines, line)
@@ -113,11 +144,11 @@ func SaveCredentialsToEnv(user, pass string) error {
 
 	// Add missing credentials
 	if !foundUser {
-		lines = append(lines, fmt.Sprintf("BGA_USER=%s", user))
+		lines = append(lines, fmt.Sprintf("BGA_USER=%s", formatEnvValue(user)))
 	}
 
 	if !foundPass {
-		lines = append(lines, fmt.Sprintf("BGA_PASS=%s", pass))
+		lines = append(lines, fmt.Sprintf("BGA_PASS=%s", formatEnvValue(pass)))
 	}
 
 	// Write the updated content
@@ -129,10 +160,15 @@ func SaveCredentialsToEnv(user, pass string) error {
 	return nil
 }
 
-// GetOrPromptCredentials gets credentials from env/file or prompts user if missing
+// GetOrPromptCredentials gets credentials from the OS keyring, then env/file, or prompts user if missing
 // If saveToEnv is true and credentials are prompted, they will be saved to .env file
 func GetOrPromptCredentials(saveToEnv bool) (username, password string, err error) {
-	// First try to get credentials from environment or .env file
+	// Prefer the keyring, the .env fallback covers machines without one such as CI
+	if user, pass, err := LoadCredentials(); err == nil {
+		return user, pass, nil
+	}
+
+	// Then try to get credentials from environment or .env file
 	user, pass, err := GetBGACredentials()
 	if err == nil {
 		return user, pass, nil
@@ -144,6 +180,36 @@ func GetOrPromptCredentials(saveToEnv bool) (username, password string, err erro
 	return "", "", fmt.Errorf("credentials not found - interactive prompting not yet implemented")
 }
 
+// GetOrReadCredentials gets credentials like GetOrPromptCredentials, reading them from r instead of failing
+// when neither the keyring nor env/file has them, so scripts can pipe them in
+func GetOrReadCredentials(r io.Reader) (username, password string, err error) {
+	if user, pass, err := GetOrPromptCredentials(false); err == nil {
+		return user, pass, nil
+	}
+
+	return ReadCredentials(r)
+}
+
+// ReadCredentials reads the BGA username and password from the first two lines of r
+func ReadCredentials(r io.Reader) (username, password string, err error) {
+	scanner := bufio.NewScanner(r)
+
+	var lines []string
+	for len(lines) < 2 && scanner.Scan() {
+		lines = append(lines, strings.TrimRight(scanner.Text(), "\r"))
+	}
+
+	if err := scanner.Err(); err != nil {
+		return "", "", fmt.Errorf("error reading credentials: %w", err)
+	}
+
+	if len(lines) < 2 || strings.TrimSpace(lines[0]) == "" || lines[1] == "" {
+		return "", "", fmt.Errorf("expected the BGA username and password on two separate lines")
+	}
+
+	return strings.TrimSpace(lines[0]), lines[1], nil
+}
+
 // PromptForCredentials interactively prompts user for BGA credentials
 // This function will be implemented with Bubble Tea for proper TUI interaction
 func PromptForCredentials() (username, password string, err error) {
@@ -104,6 +104,136 @@ func TestGetBGACredentials_MissingCredentials(t *testing.T) {
 	}
 }
 
+func TestGetBGACredentials_OnlyUserSet(t *testing.T) {
+	os.Setenv("BGA_USER", "envuser")
+	os.Unsetenv("BGA_PASS")
+	os.Remove(".env")
+	defer os.Unsetenv("BGA_USER")
+
+	_, _, err := GetBGACredentials()
+	if err == nil {
+		t.Fatal("Expected error when BGA_PASS is missing")
+	}
+
+	if err.Error() != "BGA_USER set but BGA_PASS missing" {
+		t.Errorf("Expected missing password message, got: %v", err)
+	}
+}
+
+func TestGetBGACredentials_OnlyPassSet(t *testing.T) {
+	os.Unsetenv("BGA_USER")
+	os.Setenv("BGA_PASS", "envpass")
+	os.Remove(".env")
+	defer os.Unsetenv("BGA_PASS")
+
+	_, _, err := GetBGACredentials()
+	if err == nil {
+		t.Fatal("Expected error when BGA_USER is missing")
+	}
+
+	if err.Error() != "BGA_PASS set but BGA_USER missing" {
+		t.Errorf("Expected missing user message, got: %v", err)
+	}
+}
+
+func TestGetBGACredentials_PartialEnvFile(t *testing.T) {
+	os.Unsetenv("BGA_USER")
+	os.Unsetenv("BGA_PASS")
+
+	err := os.WriteFile(".env", []byte("BGA_USER=fileuser\n"), 0644)
+	if err != nil {
+		t.Fatalf("Failed to create .env file: %v", err)
+	}
+	defer os.Remove(".env")
+
+	_, _, err = GetBGACredentials()
+	if err == nil || err.Error() != "BGA_USER set but BGA_PASS missing" {
+		t.Errorf("Expected missing password message, got: %v", err)
+	}
+}
+
+func TestGetBGACredentials_MergesEnvironmentAndEnvFile(t *testing.T) {
+	os.Setenv("BGA_USER", "envuser")
+	os.Unsetenv("BGA_PASS")
+	defer os.Unsetenv("BGA_USER")
+
+	err := os.WriteFile(".env", []byte("BGA_PASS=filepass\n"), 0644)
+	if err != nil {
+		t.Fatalf("Failed to create .env file: %v", err)
+	}
+	defer os.Remove(".env")
+
+	user, pass, err := GetBGACredentials()
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if user != "envuser" || pass != "filepass" {
+		t.Errorf("Expected envuser/filepass, got %s/%s", user, pass)
+	}
+}
+
+func TestGetBGACredentials_EnvFileValueFormats(t *testing.T) {
+	testCases := []struct {
+		name     string
+		content  string
+		wantUser string
+		wantPass string
+	}{
+		{
+			name:     "double quoted",
+			content:  "BGA_USER=\"fileuser\"\nBGA_PASS=\"my pass\"\n",
+			wantUser: "fileuser",
+			wantPass: "my pass",
+		},
+		{
+			name:     "single quoted",
+			content:  "BGA_USER='fileuser'\nBGA_PASS='my pass'\n",
+			wantUser: "fileuser",
+			wantPass: "my pass",
+		},
+		{
+			name:     "mismatched quotes kept",
+			content:  "BGA_USER=fileuser\nBGA_PASS=\"my pass'\n",
+			wantUser: "fileuser",
+			wantPass: "\"my pass'",
+		},
+		{
+			name:     "export prefix",
+			content:  "export BGA_USER=fileuser\nexport BGA_PASS=filepass\n",
+			wantUser: "fileuser",
+			wantPass: "filepass",
+		},
+		{
+			name:     "equals sign in value",
+			content:  "BGA_USER=fileuser\nBGA_PASS=a=b=c\n",
+			wantUser: "fileuser",
+			wantPass: "a=b=c",
+		},
+	}
+
+	os.Unsetenv("BGA_USER")
+	os.Unsetenv("BGA_PASS")
+	defer os.Remove(".env")
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := os.WriteFile(".env", []byte(tc.content), 0644); err != nil {
+				t.Fatalf("Failed to create .env file: %v", err)
+			}
+
+			user, pass, err := GetBGACredentials()
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+
+			if user != tc.wantUser || pass != tc.wantPass {
+				t.Errorf("Expected %q/%q, got %q/%q", tc.wantUser, tc.wantPass, user, pass)
+			}
+		})
+	}
+}
+
 func TestPromptForCredentials_ValidInput(t *testing.T) {
 	// This test would require mocking stdin/stdout for interactive testing
 	// For now, we'll test the save functionality separately
@@ -164,7 +294,59 @@ func TestSaveCredentialsToEnv_OverwriteExisting(t *testing.T) {
 	}
 }
 
+func TestSaveCredentialsToEnv_RoundTrip(t *testing.T) {
+	testCases := []struct {
+		name string
+		pass string
+	}{
+		{name: "equals signs", pass: "c2VjcmV0==a=b"},
+		{name: "surrounding quotes", pass: `"quoted"`},
+		{name: "surrounding spaces", pass: "  spaced  "},
+		{name: "plain", pass: "plainpass"},
+	}
+
+	os.Remove(".env")
+	defer os.Remove(".env")
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := SaveCredentialsToEnv("testuser", tc.pass); err != nil {
+				t.Fatalf("Expected no error saving credentials, got: %v", err)
+			}
+
+			user, pass, err := loadFromEnvFile()
+			if err != nil {
+				t.Fatalf("Expected no error loading credentials, got: %v", err)
+			}
+
+			if user != "testuser" || pass != tc.pass {
+				t.Errorf("Expected testuser/%q after the round trip, got %s/%q", tc.pass, user, pass)
+			}
+		})
+	}
+}
+
+func TestSaveCredentialsToEnv_PlainValuesStayUnquoted(t *testing.T) {
+	os.Remove(".env")
+	defer os.Remove(".env")
+
+	if err := SaveCredentialsToEnv("testuser", "pa=ss"); err != nil {
+		t.Fatalf("Expected no error saving credentials, got: %v", err)
+	}
+
+	content, err := os.ReadFile(".env")
+	if err != nil {
+		t.Fatalf("Failed to read .env file: %v", err)
+	}
+
+	if string(content) != "BGA_USER=testuser\nBGA_PASS=pa=ss\n" {
+		t.Errorf("Expected unquoted values, got %q", string(content))
+	}
+}
+
 func TestGetOrPromptCredentials_Found(t *testing.T) {
+	mockKeyring(t)
+
 	// Setup environment variables
 	os.Setenv("BGA_USER", "envuser")
 	os.Setenv("BGA_PASS", "envpass")
@@ -188,6 +370,8 @@ func TestGetOrPromptCredentials_Found(t *testing.T) {
 }
 
 func TestGetOrPromptCredentials_NotFound(t *testing.T) {
+	mockKeyring(t)
+
 	// Ensure no credentials available
 	os.Unsetenv("BGA_USER")
 	os.Unsetenv("BGA_PASS")
@@ -200,3 +384,75 @@ func TestGetOrPromptCredentials_NotFound(t *testing.T) {
 		t.Errorf("Expected error when credentials not found and prompting disabled")
 	}
 }
+
+func TestReadCredentials(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		wantUser string
+		wantPass string
+		wantErr  bool
+	}{
+		{name: "two lines", input: "pipeuser\npipe pass\n", wantUser: "pipeuser", wantPass: "pipe pass"},
+		{name: "no trailing newline", input: "pipeuser\npipepass", wantUser: "pipeuser", wantPass: "pipepass"},
+		{name: "windows line endings", input: "pipeuser\r\npipepass\r\n", wantUser: "pipeuser", wantPass: "pipepass"},
+		{name: "extra lines ignored", input: "pipeuser\npipepass\nmore\n", wantUser: "pipeuser", wantPass: "pipepass"},
+		{name: "password missing", input: "pipeuser\n", wantErr: true},
+		{name: "empty input", input: "", wantErr: true},
+		{name: "empty username", input: "\npipepass\n", wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			user, pass, err := ReadCredentials(strings.NewReader(tc.input))
+			if tc.wantErr {
+				if err == nil {
+					t.Errorf("Expected an error, got %q/%q", user, pass)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			if user != tc.wantUser || pass != tc.wantPass {
+				t.Errorf("Expected %q/%q, got %q/%q", tc.wantUser, tc.wantPass, user, pass)
+			}
+		})
+	}
+}
+
+func TestGetOrReadCredentials_ReadsWhenNothingElseIsSet(t *testing.T) {
+	mockKeyring(t)
+	os.Unsetenv("BGA_USER")
+	os.Unsetenv("BGA_PASS")
+	os.Remove(".env")
+
+	user, pass, err := GetOrReadCredentials(strings.NewReader("pipeuser\npipepass\n"))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if user != "pipeuser" || pass != "pipepass" {
+		t.Errorf("Expected pipeuser/pipepass, got %s/%s", user, pass)
+	}
+}
+
+func TestGetOrReadCredentials_EnvironmentTakesPrecedence(t *testing.T) {
+	mockKeyring(t)
+	os.Setenv("BGA_USER", "envuser")
+	os.Setenv("BGA_PASS", "envpass")
+	defer func() {
+		os.Unsetenv("BGA_USER")
+		os.Unsetenv("BGA_PASS")
+	}()
+
+	user, pass, err := GetOrReadCredentials(strings.NewReader("pipeuser\npipepass\n"))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if user != "envuser" || pass != "envpass" {
+		t.Errorf("Expected the environment credentials, got %s/%s", user, pass)
+	}
+}
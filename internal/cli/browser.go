@@ -0,0 +1,25 @@
+package cli
+
+import (
+	"os/exec"
+	"runtime"
+)
+
+// browserOpen opens a URL in the default browser, replaced in tests
+var browserOpen = openBrowser
+
+// openBrowser opens the URL with the platform's launcher without waiting for the browser to exit
+func openBrowser(url string) error {
+	var cmd *exec.Cmd
+
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", url)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		cmd = exec.Command("xdg-open", url)
+	}
+
+	return cmd.Start()
+}
@@ -0,0 +1,15 @@
+package cli
+
+import (
+	"testing"
+	"time"
+)
+
+// freezeClock makes now return at for the rest of the test
+func freezeClock(t *testing.T, at time.Time) {
+	t.Helper()
+
+	original := now
+	now = func() time.Time { return at }
+	t.Cleanup(func() { now = original })
+}
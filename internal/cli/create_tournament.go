@@ -0,0 +1,160 @@
+package cli
+
+import (
+	"fmt"
+
+	"carca-cli/internal/fixtures"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// CreateTournamentSelectMsg is sent when user selects "Create Tournament" from main menu
+type CreateTournamentSelectMsg struct{}
+
+// CreateTournamentModel lists a division's unplayed matches and schedules a tournament for the chosen one
+// The datetime picker, confirmation and creation are handled by a fixture model kept off screen
+type CreateTournamentModel struct {
+	fixture *FixtureModel
+	style   lipgloss.Style
+	matches []*fixtures.Match
+	cursor  int
+}
+
+// NewCreateTournamentModel creates the unplayed match list driving the given fixture model
+func NewCreateTournamentModel(fixture *FixtureModel) *CreateTournamentModel {
+	return &CreateTournamentModel{
+		fixture: fixture,
+		matches: fixtures.GetUnplayedMatches(fixture.division),
+		style: lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#7D56F4")).
+			Bold(true),
+	}
+}
+
+// Init initializes the create tournament model (required by Bubble Tea)
+func (m *CreateTournamentModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update handles the match list keys, handing everything else to the fixture model
+func (m *CreateTournamentModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok || m.scheduling() {
+		return m, m.updateFixture(msg)
+	}
+
+	switch keyMsg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+	case "esc":
+		if m.fixture.cancelCreate != nil {
+			return m, m.updateFixture(msg)
+		}
+		return m, func() tea.Msg { return BackToMenuMsg{} }
+	case "q":
+		return m, func() tea.Msg { return BackToMenuMsg{} }
+	case "down", "j":
+		m.moveCursor(1)
+	case "up", "k":
+		m.moveCursor(-1)
+	case "enter":
+		return m, m.scheduleSelected()
+	}
+
+	return m, nil
+}
+
+// scheduling reports whether the datetime picker, the confirmation or a creation error is on screen
+func (m *CreateTournamentModel) scheduling() bool {
+	return m.fixture.showDatePicker || m.fixture.showConfirmation || m.fixture.errorModel != nil
+}
+
+// updateFixture forwards a message to the fixture model
+func (m *CreateTournamentModel) updateFixture(msg tea.Msg) tea.Cmd {
+	updatedModel, cmd := m.fixture.Update(msg)
+	if fixModel, ok := updatedModel.(*FixtureModel); ok {
+		m.fixture = fixModel
+	}
+
+	return cmd
+}
+
+// moveCursor moves the selection through the unplayed matches, wrapping at both ends
+func (m *CreateTournamentModel) moveCursor(direction int) {
+	if len(m.matches) == 0 {
+		return
+	}
+
+	m.cursor = (m.cursor + direction + len(m.matches)) % len(m.matches)
+}
+
+// scheduleSelected opens the datetime picker for the selected match
+func (m *CreateTournamentModel) scheduleSelected() tea.Cmd {
+	if m.cursor >= len(m.matches) {
+		return nil
+	}
+
+	selected := m.matches[m.cursor]
+	for roundIndex, round := range m.fixture.division.Rounds {
+		for matchIndex, match := range round.Matches {
+			if match == selected {
+				m.fixture.currentRound, m.fixture.selectedMatch = roundIndex, matchIndex
+				_, cmd := m.fixture.handleCreateTournament()
+				return cmd
+			}
+		}
+	}
+
+	return nil
+}
+
+// SelectedMatch returns the highlighted unplayed match, or nil when there is none
+func (m *CreateTournamentModel) SelectedMatch() *fixtures.Match {
+	if m.cursor >= len(m.matches) {
+		return nil
+	}
+
+	return m.matches[m.cursor]
+}
+
+// View renders the unplayed matches, or the scheduling step in progress
+func (m *CreateTournamentModel) View() string {
+	if m.scheduling() {
+		return m.fixture.View()
+	}
+
+	title := m.style.Render(fmt.Sprintf("Division %s - Create Tournament", m.fixture.division.Name))
+	s := fmt.Sprintf("\n%s\n\n", title)
+
+	if len(m.matches) == 0 {
+		s += lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#FF6B6B")).
+			Render("All matches in this division have been played")
+	}
+
+	for i, match := range m.matches {
+		cursor := " "
+		line := fmt.Sprintf("Duelo %d: %s vs %s", match.ID, match.HomePlayer, match.AwayPlayer)
+		if match.HasAgreedDateTime() {
+			line += " - " + match.DateTime
+		}
+		if match.BGALink != "" {
+			line += " (tournament created)"
+		}
+		if m.cursor == i {
+			cursor = ">"
+			line = m.style.Render(line)
+		}
+
+		s += fmt.Sprintf("%s %s\n", cursor, line)
+	}
+
+	if m.fixture.statusMessage != "" {
+		s += "\n" + m.fixture.renderStatus()
+	}
+
+	s += "\n\nPress ↑/↓ or j/k to select a match, enter to schedule its tournament, esc/q to go back.\n"
+
+	return s
+}
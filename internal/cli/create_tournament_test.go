@@ -0,0 +1,128 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"carca-cli/internal/fixtures"
+)
+
+func createTournamentTestDivision() *fixtures.Division {
+	return &fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, Matches: []*fixtures.Match{
+				{ID: 1, HomePlayer: "alice", AwayPlayer: "bob", Played: true, HomeScore: 2, AwayScore: 1},
+				{ID: 2, HomePlayer: "carol", AwayPlayer: "dave"},
+			}},
+			{Number: 2, Matches: []*fixtures.Match{
+				{ID: 3, HomePlayer: "alice", AwayPlayer: "carol"},
+			}},
+		},
+	}
+}
+
+func TestCreateTournamentModel_ListsOnlyUnplayedMatches(t *testing.T) {
+	model := NewCreateTournamentModel(NewFixtureModel(createTournamentTestDivision()))
+
+	view := model.View()
+	if strings.Contains(view, "alice vs bob") {
+		t.Errorf("Expected played matches to be left out, got:\n%s", view)
+	}
+	if !strings.Contains(view, "carol vs dave") || !strings.Contains(view, "alice vs carol") {
+		t.Errorf("Expected both unplayed matches, got:\n%s", view)
+	}
+}
+
+func TestCreateTournamentModel_NavigationWraps(t *testing.T) {
+	model := NewCreateTournamentModel(NewFixtureModel(createTournamentTestDivision()))
+
+	_, _ = model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	if got := model.SelectedMatch(); got == nil || got.ID != 3 {
+		t.Fatalf("Expected Duelo 3 after moving down, got %+v", got)
+	}
+
+	_, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}})
+	if got := model.SelectedMatch(); got == nil || got.ID != 2 {
+		t.Errorf("Expected selection to wrap to Duelo 2, got %+v", got)
+	}
+}
+
+func TestCreateTournamentModel_EnterOpensDatePickerForSelectedMatch(t *testing.T) {
+	model := NewCreateTournamentModel(NewFixtureModel(createTournamentTestDivision()))
+
+	_, _ = model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	_, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	if !model.fixture.showDatePicker || model.fixture.dateTimePicker == nil {
+		t.Fatal("Expected the datetime picker to open")
+	}
+
+	picker := model.fixture.dateTimePicker
+	if picker.homePlayer != "alice" || picker.awayPlayer != "carol" || picker.roundNumber != 2 {
+		t.Errorf("Expected the picker for alice vs carol in round 2, got %s vs %s in round %d",
+			picker.homePlayer, picker.awayPlayer, picker.roundNumber)
+	}
+
+	// Keys now belong to the picker, so esc cancels it instead of leaving the screen
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if cmd != nil {
+		if _, ok := cmd().(BackToMenuMsg); ok {
+			t.Error("Expected esc to go to the picker, not back to the menu")
+		}
+	}
+}
+
+func TestCreateTournamentModel_EscGoesBackToMenu(t *testing.T) {
+	model := NewCreateTournamentModel(NewFixtureModel(createTournamentTestDivision()))
+
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if cmd == nil {
+		t.Fatal("Expected esc to leave the screen")
+	}
+
+	if _, ok := cmd().(BackToMenuMsg); !ok {
+		t.Error("Expected BackToMenuMsg")
+	}
+}
+
+func TestAppModel_CreateTournamentFlow(t *testing.T) {
+	model := NewAppModel()
+	model.menuModel.cursor = 0
+
+	_, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if model.GetCurrentScreen() != ScreenDivisionSelect {
+		t.Fatalf("Expected division selection first, got %v", model.GetCurrentScreen())
+	}
+
+	_, _ = model.Update(DivisionSelectMsg{
+		Division: "Elite",
+		Filename: "../../data/Liga Argentina - 1° Temporada - E-Fixture.csv",
+	})
+
+	if model.GetCurrentScreen() != ScreenCreateTournament || model.createModel == nil {
+		t.Fatalf("Expected the unplayed match list, got %v", model.GetCurrentScreen())
+	}
+
+	for _, match := range model.createModel.matches {
+		if match.Played {
+			t.Errorf("Expected only unplayed matches, got Duelo %d", match.ID)
+		}
+	}
+
+	if view := model.View(); !strings.Contains(view, "Create Tournament") {
+		t.Errorf("Expected the create tournament screen, got:\n%s", view)
+	}
+
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if cmd == nil {
+		t.Fatal("Expected esc to leave the unplayed match list")
+	}
+
+	_, _ = model.Update(cmd())
+	if model.GetCurrentScreen() != ScreenMenu || model.createModel != nil {
+		t.Errorf("Expected to be back on the menu, got %v", model.GetCurrentScreen())
+	}
+}
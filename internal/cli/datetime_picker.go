@@ -15,6 +15,7 @@ type DateTimePickerModel struct {
 	picker       *bubbledatetimepicker.DateAndHourModel
 	timezone     *time.Location
 	selectedTime time.Time
+	zones        []*time.Location
 	style        lipgloss.Style
 	title        string
 	instructions string
@@ -26,6 +27,37 @@ type DateTimePickerModel struct {
 	matchID      int
 	confirmed    bool
 	canceled     bool
+	use24Hour    bool
+	pickingTime  bool
+}
+
+// pickerInstructions lists the keys understood by the datetime picker
+const pickerInstructions = "Use ↑/↓ to change date, ←/→ to move between date/time, " +
+	"t to change timezone, Enter to confirm, Esc to cancel"
+
+// pickerTimezones are the zones offered after the local one, most leagues being played on Argentina time
+var pickerTimezones = []string{"America/Argentina/Buenos_Aires", "UTC"}
+
+// timezoneChoices returns the given zone followed by the pickerTimezones that load and differ from it
+func timezoneChoices(first *time.Location) []*time.Location {
+	zones := []*time.Location{first}
+	for _, name := range pickerTimezones {
+		zone, err := time.LoadLocation(name)
+		if err != nil || zone.String() == first.String() {
+			continue
+		}
+		zones = append(zones, zone)
+	}
+
+	return zones
+}
+
+// displayTimeLayout returns the layout used to show a full date and time, in 24-hour or 12-hour form
+func displayTimeLayout(use24Hour bool) string {
+	if use24Hour {
+		return "Monday, January 2, 2006 at 15:04"
+	}
+	return "Monday, January 2, 2006 at 3:04 PM"
 }
 
 // DateTimeSelectedMsg is sent when a datetime is selected
@@ -43,30 +75,35 @@ type DateTimeSelectedMsg struct {
 type DateTimePickerCanceledMsg struct{}
 
 // NewDateTimePickerModel creates a new datetime picker model
+// A non-zero defaultDate opens the picker on that day at batchStartHour instead of today
 func NewDateTimePickerModel(
 	homePlayer, awayPlayer, division string,
 	roundNumber, matchNumber, matchID int,
+	defaultDate time.Time,
 ) *DateTimePickerModel {
 	// Get local timezone
-	localTZ := time.Now().Location()
+	localTZ := now().Location()
 
 	// Create picker with default settings
 	picker := bubbledatetimepicker.NewDateAndHourModel()
+	if !defaultDate.IsZero() {
+		seekPicker(&picker, defaultDate)
+	}
 
 	title := fmt.Sprintf("Schedule Tournament: %s vs %s", homePlayer, awayPlayer)
 
 	return &DateTimePickerModel{
-		picker: &picker,
-		title:  title,
-		instructions: "Use ↑/↓ to change date, ←/→ to move between date/time, " +
-			"Enter to confirm, Esc to cancel",
-		timezone:    localTZ,
-		homePlayer:  homePlayer,
-		awayPlayer:  awayPlayer,
-		division:    division,
-		roundNumber: roundNumber,
-		matchNumber: matchNumber,
-		matchID:     matchID,
+		picker:       &picker,
+		title:        title,
+		instructions: pickerInstructions,
+		timezone:     localTZ,
+		zones:        timezoneChoices(localTZ),
+		homePlayer:   homePlayer,
+		awayPlayer:   awayPlayer,
+		division:     division,
+		roundNumber:  roundNumber,
+		matchNumber:  matchNumber,
+		matchID:      matchID,
 		style: lipgloss.NewStyle().
 			Padding(1).
 			Border(lipgloss.RoundedBorder()).
@@ -88,17 +125,12 @@ func NewDateTimePickerModelWithTime(
 
 	title := fmt.Sprintf("Edit Tournament: %s vs %s", homePlayer, awayPlayer)
 
-	// Format the previous time for display in instructions
-	prevTimeStr := initialTime.Format("Monday, January 2, 2006 at 3:04 PM")
-	instructions := fmt.Sprintf("Previously selected: %s\n"+
-		"Use ↑/↓ to change date, ←/→ to move between date/time, "+
-		"Enter to confirm, Esc to cancel", prevTimeStr)
-
 	return &DateTimePickerModel{
 		picker:       &picker,
 		title:        title,
-		instructions: instructions,
+		instructions: editInstructions(initialTime, false),
 		timezone:     timezone,
+		zones:        timezoneChoices(timezone),
 		selectedTime: initialTime,
 		homePlayer:   homePlayer,
 		awayPlayer:   awayPlayer,
@@ -113,6 +145,52 @@ func NewDateTimePickerModelWithTime(
 	}
 }
 
+// seekPicker moves the picker to the given day at batchStartHour
+// The picker has no setter, so this presses the keys a user would: weeks and days on the calendar,
+// then the hour, coming back to the calendar afterwards
+func seekPicker(picker *bubbledatetimepicker.DateAndHourModel, date time.Time) {
+	from := picker.Time()
+	days := int(time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC).
+		Sub(time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)).Hours() / 24)
+
+	var keys []tea.KeyType
+	for ; days >= 7; days -= 7 {
+		keys = append(keys, tea.KeyDown)
+	}
+	for ; days <= -7; days += 7 {
+		keys = append(keys, tea.KeyUp)
+	}
+	for ; days > 0; days-- {
+		keys = append(keys, tea.KeyRight)
+	}
+	for ; days < 0; days++ {
+		keys = append(keys, tea.KeyLeft)
+	}
+
+	keys = append(keys, tea.KeyEnter)
+	for hour := from.Hour(); hour != batchStartHour; hour = (hour + 1) % 24 {
+		keys = append(keys, tea.KeyUp)
+	}
+	keys = append(keys, tea.KeyDelete)
+
+	for _, keyType := range keys {
+		picker.Update(tea.KeyMsg{Type: keyType})
+	}
+}
+
+// editInstructions prefixes the picker instructions with the previously selected time
+func editInstructions(previous time.Time, use24Hour bool) string {
+	return fmt.Sprintf("Previously selected: %s\n%s", previous.Format(displayTimeLayout(use24Hour)), pickerInstructions)
+}
+
+// SetUse24Hour switches the displayed times between 24-hour and 12-hour clocks
+func (m *DateTimePickerModel) SetUse24Hour(use24Hour bool) {
+	m.use24Hour = use24Hour
+	if !m.selectedTime.IsZero() {
+		m.instructions = editInstructions(m.selectedTime, use24Hour)
+	}
+}
+
 // Init initializes the datetime picker
 func (m *DateTimePickerModel) Init() tea.Cmd {
 	return m.picker.Init()
@@ -121,6 +199,14 @@ func (m *DateTimePickerModel) Init() tea.Cmd {
 // dateTimePickerConfirmedMsg is an internal message to signal confirmation from the picker
 type dateTimePickerConfirmedMsg struct{}
 
+// applyPickerUpdate stores the model returned by the picker's Update
+// An unexpected model type is ignored so the previous picker keeps rendering instead of being lost
+func (m *DateTimePickerModel) applyPickerUpdate(updated tea.Model) {
+	if picker, ok := updated.(*bubbledatetimepicker.DateAndHourModel); ok && picker != nil {
+		m.picker = picker
+	}
+}
+
 // Update handles messages for the datetime picker
 func (m *DateTimePickerModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmd tea.Cmd
@@ -130,7 +216,7 @@ func (m *DateTimePickerModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	// Handle our internal confirmation message
 	case dateTimePickerConfirmedMsg:
 		m.confirmed = true
-		m.selectedTime = m.picker.Time()
+		m.selectedTime = m.pickedTime()
 
 		return m, tea.Cmd(func() tea.Msg {
 			return DateTimeSelectedMsg{
@@ -152,30 +238,82 @@ func (m *DateTimePickerModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, tea.Cmd(func() tea.Msg {
 				return DateTimePickerCanceledMsg{}
 			})
+		case key.Matches(msg, key.NewBinding(key.WithKeys("t"))):
+			m.cycleTimezone()
+			return m, nil
 		}
 	}
 
 	// Update the picker
 	pickerModel, cmd = m.picker.Update(msg)
-	if picker, ok := pickerModel.(*bubbledatetimepicker.DateAndHourModel); ok {
-		m.picker = picker
+	m.applyPickerUpdate(pickerModel)
+	m.trackStep(msg)
+
+	return m, confirmOnQuit(cmd)
+}
+
+// confirmOnQuit wraps a command from the picker, which sends tea.Quit when Enter is pressed on the time view
+// Only a QuitMsg becomes the internal confirmation; any other message, such as one sent on a navigation key,
+// is passed through intact, and batches are unwrapped so a Quit inside them can't close the app
+func confirmOnQuit(cmd tea.Cmd) tea.Cmd {
+	if cmd == nil {
+		return nil
 	}
 
-	// The picker returns a tea.Quit command when Enter is pressed on the time view.
-	// We wrap the command to intercept the QuitMsg and convert it into our own
-	// internal confirmation message.
-	if cmd != nil {
-		wrappedCmd := func() tea.Msg {
-			msg := cmd() // execute original command
-			if _, ok := msg.(tea.QuitMsg); ok {
-				return dateTimePickerConfirmedMsg{}
+	return func() tea.Msg {
+		switch msg := cmd().(type) {
+		case tea.QuitMsg:
+			return dateTimePickerConfirmedMsg{}
+		case tea.BatchMsg:
+			wrapped := make(tea.BatchMsg, len(msg))
+			for i, inner := range msg {
+				wrapped[i] = confirmOnQuit(inner)
 			}
-			return msg // return original message
+			return wrapped
+		default:
+			return msg
+		}
+	}
+}
+
+// trackStep follows the picker moving to the time on Enter and back to the date on Delete
+// The picker keeps its focus to itself, so this mirrors the keys it reacts to
+func (m *DateTimePickerModel) trackStep(msg tea.Msg) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return
+	}
+
+	switch keyMsg.String() {
+	case "enter":
+		m.pickingTime = true
+	case "delete":
+		m.pickingTime = false
+	}
+}
+
+// stepIndicator tells which of the two Enters the user is at
+func (m *DateTimePickerModel) stepIndicator() string {
+	if m.pickingTime {
+		return "Step 2/2: Select time — press Enter to confirm"
+	}
+	return "Step 1/2: Select date — press Enter"
+}
+
+// cycleTimezone switches the picked wall clock to the next timezone choice
+func (m *DateTimePickerModel) cycleTimezone() {
+	for i, zone := range m.zones {
+		if zone == m.timezone {
+			m.timezone = m.zones[(i+1)%len(m.zones)]
+			return
 		}
-		return m, wrappedCmd
 	}
+}
 
-	return m, cmd
+// pickedTime reads the date and hour set on the picker as a wall clock in the chosen timezone
+func (m *DateTimePickerModel) pickedTime() time.Time {
+	picked := m.picker.Time()
+	return time.Date(picked.Year(), picked.Month(), picked.Day(), picked.Hour(), picked.Minute(), 0, 0, m.timezone)
 }
 
 // View renders the datetime picker
@@ -185,7 +323,7 @@ func (m *DateTimePickerModel) View() string {
 	}
 
 	// Get current selected time for display
-	currentTime := m.picker.Time()
+	currentTime := m.pickedTime()
 
 	// Format timezone offset
 	_, offset := currentTime.Zone()
@@ -203,12 +341,13 @@ func (m *DateTimePickerModel) View() string {
 	content := fmt.Sprintf("%s\n\n", m.title)
 	content += fmt.Sprintf("Division: %s - Round %d - Duelo %d\n", m.division, m.roundNumber, m.matchNumber)
 	content += fmt.Sprintf("Timezone: %s (%s)\n\n", m.timezone.String(), offsetStr)
+	content += m.stepIndicator() + "\n\n"
 
 	// Add the picker
 	content += m.picker.View()
 
 	content += fmt.Sprintf("\n\nSelected: %s",
-		currentTime.Format("Monday, January 2, 2006 at 3:04 PM"))
+		currentTime.Format(displayTimeLayout(m.use24Hour)))
 	content += fmt.Sprintf(" (%s)", offsetStr)
 
 	content += "\n\n" + m.instructions
@@ -219,7 +358,7 @@ func (m *DateTimePickerModel) View() string {
 // GetSelectedTime returns the selected time
 func (m *DateTimePickerModel) GetSelectedTime() time.Time {
 	if m.selectedTime.IsZero() {
-		return m.picker.Time()
+		return m.pickedTime()
 	}
 	return m.selectedTime
 }
@@ -234,11 +373,10 @@ func (m *DateTimePickerModel) IsCanceled() bool {
 	return m.canceled
 }
 
-// FormatForBGA formats the selected time for BGA API
+// FormatForBGA formats the selected time for BGA API, as a wall clock in the chosen timezone
 func (m *DateTimePickerModel) FormatForBGA() (date, timeStr string) {
 	if m.selectedTime.IsZero() {
-		now := time.Now()
-		return now.Format("2006-01-02"), "21:00"
+		return now().In(m.timezone).Format("2006-01-02"), "21:00"
 	}
 
 	return m.selectedTime.Format("2006-01-02"), m.selectedTime.Format("15:04")
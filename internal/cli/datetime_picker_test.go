@@ -5,6 +5,8 @@ import (
 	"testing"
 	"time"
 
+	"carca-cli/internal/fixtures"
+
 	tea "github.com/charmbracelet/bubbletea"
 )
 
@@ -16,7 +18,7 @@ func TestNewDateTimePickerModel(t *testing.T) {
 	matchNumber := 15
 	matchID := 15
 
-	picker := NewDateTimePickerModel(homePlayer, awayPlayer, division, roundNumber, matchNumber, matchID)
+	picker := NewDateTimePickerModel(homePlayer, awayPlayer, division, roundNumber, matchNumber, matchID, time.Time{})
 
 	if picker.homePlayer != homePlayer {
 		t.Errorf("Expected homePlayer %s, got %s", homePlayer, picker.homePlayer)
@@ -61,7 +63,7 @@ func TestNewDateTimePickerModel(t *testing.T) {
 }
 
 func TestDateTimePickerModel_Init(t *testing.T) {
-	picker := NewDateTimePickerModel("player1", "player2", "Elite", 1, 15, 15)
+	picker := NewDateTimePickerModel("player1", "player2", "Elite", 1, 15, 15, time.Time{})
 
 	cmd := picker.Init()
 	// The underlying picker might not always return a command
@@ -69,7 +71,7 @@ func TestDateTimePickerModel_Init(t *testing.T) {
 }
 
 func TestDateTimePickerModel_Update_Enter(t *testing.T) {
-	picker := NewDateTimePickerModel("player1", "player2", "Elite", 1, 15, 15)
+	picker := NewDateTimePickerModel("player1", "player2", "Elite", 1, 15, 15, time.Time{})
 
 	// 1. First Enter: Should switch focus in the picker.
 	// The picker's Update returns a model and a command.
@@ -138,7 +140,7 @@ func TestDateTimePickerModel_Update_Enter(t *testing.T) {
 }
 
 func TestDateTimePickerModel_Update_Escape(t *testing.T) {
-	picker := NewDateTimePickerModel("player1", "player2", "Elite", 1, 15, 15)
+	picker := NewDateTimePickerModel("player1", "player2", "Elite", 1, 15, 15, time.Time{})
 
 	// Send escape key
 	updatedModel, cmd := picker.Update(tea.KeyMsg{Type: tea.KeyEsc})
@@ -162,7 +164,7 @@ func TestDateTimePickerModel_Update_Escape(t *testing.T) {
 }
 
 func TestDateTimePickerModel_View(t *testing.T) {
-	picker := NewDateTimePickerModel("herchu", "Lord Trooper", "Elite", 1, 15, 15)
+	picker := NewDateTimePickerModel("herchu", "Lord Trooper", "Elite", 1, 15, 15, time.Time{})
 
 	view := picker.View()
 
@@ -186,7 +188,7 @@ func TestDateTimePickerModel_View(t *testing.T) {
 }
 
 func TestDateTimePickerModel_View_ConfirmedOrCanceled(t *testing.T) {
-	picker := NewDateTimePickerModel("player1", "player2", "Elite", 1, 15, 15)
+	picker := NewDateTimePickerModel("player1", "player2", "Elite", 1, 15, 15, time.Time{})
 
 	// Test confirmed state
 	picker.confirmed = true
@@ -205,16 +207,17 @@ func TestDateTimePickerModel_View_ConfirmedOrCanceled(t *testing.T) {
 }
 
 func TestDateTimePickerModel_FormatForBGA(t *testing.T) {
-	picker := NewDateTimePickerModel("player1", "player2", "Elite", 1, 15, 15)
+	freezeClock(t, time.Date(2025, 9, 14, 10, 0, 0, 0, time.Local))
+	picker := NewDateTimePickerModel("player1", "player2", "Elite", 1, 15, 15, time.Time{})
 
 	// Test with zero time (should return defaults)
 	date, timeStr := picker.FormatForBGA()
 	if timeStr != "21:00" {
 		t.Errorf("Expected default time '21:00', got %s", timeStr)
 	}
-	// Date should be current date in YYYY-MM-DD format
-	if len(date) != 10 || date[4] != '-' || date[7] != '-' {
-		t.Errorf("Expected date in YYYY-MM-DD format, got %s", date)
+	// Date should be the current date in YYYY-MM-DD format
+	if date != "2025-09-14" {
+		t.Errorf("Expected the frozen date 2025-09-14, got %s", date)
 	}
 
 	// Test with specific time
@@ -234,7 +237,7 @@ func TestDateTimePickerModel_FormatForBGA(t *testing.T) {
 }
 
 func TestDateTimePickerModel_GetSelectedTime(t *testing.T) {
-	picker := NewDateTimePickerModel("player1", "player2", "Elite", 1, 15, 15)
+	picker := NewDateTimePickerModel("player1", "player2", "Elite", 1, 15, 15, time.Time{})
 
 	// Initially should return time from picker (not necessarily zero)
 	selectedTime := picker.GetSelectedTime()
@@ -253,7 +256,7 @@ func TestDateTimePickerModel_GetSelectedTime(t *testing.T) {
 }
 
 func TestDateTimePickerModel_TimezoneDisplay(t *testing.T) {
-	picker := NewDateTimePickerModel("player1", "player2", "Elite", 1, 15, 15)
+	picker := NewDateTimePickerModel("player1", "player2", "Elite", 1, 15, 15, time.Time{})
 
 	view := picker.View()
 
@@ -269,7 +272,7 @@ func TestDateTimePickerModel_TimezoneDisplay(t *testing.T) {
 }
 
 func TestDateTimePickerModel_PlatinumDivision(t *testing.T) {
-	picker := NewDateTimePickerModel("webbi", "alehrosario", "Platinum A", 5, 23, 23)
+	picker := NewDateTimePickerModel("webbi", "alehrosario", "Platinum A", 5, 23, 23, time.Time{})
 
 	view := picker.View()
 
@@ -285,7 +288,7 @@ func TestDateTimePickerModel_PlatinumDivision(t *testing.T) {
 }
 
 func TestDateTimePickerModel_StateMethods(t *testing.T) {
-	picker := NewDateTimePickerModel("player1", "player2", "Elite", 1, 15, 15)
+	picker := NewDateTimePickerModel("player1", "player2", "Elite", 1, 15, 15, time.Time{})
 
 	// Initially not confirmed or canceled
 	if picker.IsConfirmed() {
@@ -310,7 +313,7 @@ func TestDateTimePickerModel_StateMethods(t *testing.T) {
 }
 
 func TestDateTimePickerModel_KeyHandling(t *testing.T) {
-	picker := NewDateTimePickerModel("player1", "player2", "Elite", 1, 15, 15)
+	picker := NewDateTimePickerModel("player1", "player2", "Elite", 1, 15, 15, time.Time{})
 
 	// Test that arrow keys are passed through to the picker
 	testKeys := []tea.KeyMsg{
@@ -338,7 +341,7 @@ func TestDateTimePickerModel_KeyHandling(t *testing.T) {
 }
 
 func TestDateTimePickerModel_NavigationInstructions(t *testing.T) {
-	picker := NewDateTimePickerModel("player1", "player2", "Elite", 1, 15, 15)
+	picker := NewDateTimePickerModel("player1", "player2", "Elite", 1, 15, 15, time.Time{})
 
 	view := picker.View()
 
@@ -435,3 +438,281 @@ func TestEditDateTimeInstructions(t *testing.T) {
 		t.Error("Expected view to show 'Previously selected:' in instructions")
 	}
 }
+
+func TestEditDateTimeInstructions_24Hour(t *testing.T) {
+	targetTime := time.Date(2025, 3, 15, 14, 30, 0, 0, time.Local)
+
+	picker := NewDateTimePickerModelWithTime(
+		"herchu", "Lord Trooper", "Elite", 1, 15, 15, targetTime,
+	)
+	picker.SetUse24Hour(true)
+
+	if !strings.Contains(picker.instructions, "Saturday, March 15, 2025 at 14:30") {
+		t.Errorf("Expected instructions in 24-hour format, got '%s'", picker.instructions)
+	}
+
+	picker.SetUse24Hour(false)
+	if !strings.Contains(picker.instructions, "Saturday, March 15, 2025 at 2:30 PM") {
+		t.Errorf("Expected instructions back in 12-hour format, got '%s'", picker.instructions)
+	}
+}
+
+// fakePickerModel stands in for a picker that returns an unexpected model type
+type fakePickerModel struct{}
+
+func (fakePickerModel) Init() tea.Cmd                       { return nil }
+func (fakePickerModel) Update(tea.Msg) (tea.Model, tea.Cmd) { return fakePickerModel{}, nil }
+func (fakePickerModel) View() string                        { return "fake" }
+
+func TestDateTimePickerModel_ApplyPickerUpdate_KeepsPickerOnMismatch(t *testing.T) {
+	model := NewDateTimePickerModel("herchu", "webbi", "Elite", 1, 1, 1, time.Time{})
+	original := model.picker
+
+	model.applyPickerUpdate(fakePickerModel{})
+	if model.picker != original {
+		t.Fatal("Expected picker to be kept when Update returns an unexpected model")
+	}
+
+	model.applyPickerUpdate(nil)
+	if model.picker != original {
+		t.Fatal("Expected picker to be kept when Update returns nil")
+	}
+
+	if view := model.View(); view == "" {
+		t.Error("Expected view to keep rendering after a mismatched update")
+	}
+}
+
+func TestNewDateTimePickerModel_DefaultDate(t *testing.T) {
+	today := time.Now()
+	for _, defaultDate := range []time.Time{today.AddDate(0, 0, 40), today.AddDate(0, 0, -10), today} {
+		picker := NewDateTimePickerModel("player1", "player2", "Elite", 1, 15, 15, defaultDate)
+
+		got := picker.GetSelectedTime()
+		if got.Format("2006-01-02") != defaultDate.Format("2006-01-02") {
+			t.Errorf("Expected picker to open on %s, got %s", defaultDate.Format("2006-01-02"), got.Format("2006-01-02"))
+		}
+		if got.Hour() != batchStartHour {
+			t.Errorf("Expected picker to open at %d:00, got %s", batchStartHour, got.Format("15:04"))
+		}
+
+		// The calendar keeps the focus, so the arrows still move the day
+		picker.Update(tea.KeyMsg{Type: tea.KeyRight})
+		if moved := picker.GetSelectedTime(); !moved.After(got) {
+			t.Errorf("Expected right to move past %s, got %s", got, moved)
+		}
+	}
+}
+
+func TestFixtureModel_CreateTournament_PickerOpensOnRoundStart(t *testing.T) {
+	nextMonth := time.Now().AddDate(0, 1, 0)
+	start := time.Date(nextMonth.Year(), nextMonth.Month(), 10, 0, 0, 0, 0, time.Local)
+	end := start.AddDate(0, 0, 6)
+	// Round dates carry no year, so keep the clock in the round's year even in December
+	freezeClock(t, start.AddDate(0, 0, -20))
+
+	division := &fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{
+				Number:    1,
+				DateRange: start.Format("02/01") + " - " + end.Format("02/01"),
+				Matches:   []*fixtures.Match{{ID: 1, HomePlayer: "herchu", AwayPlayer: "webbi"}},
+			},
+		},
+	}
+	model := NewFixtureModel(division)
+	model.handleCreateTournament()
+
+	if model.dateTimePicker == nil {
+		t.Fatal("Expected the datetime picker to open")
+	}
+
+	got := model.dateTimePicker.GetSelectedTime()
+	if got.Before(start) || got.After(end.AddDate(0, 0, 1)) {
+		t.Errorf("Expected picker to open within %s, got %s", division.Rounds[0].DateRange, got)
+	}
+}
+
+func TestFixtureModel_CreateTournament_PickerOpensOnRoundStartAfterNewYear(t *testing.T) {
+	year := time.Now().Year()
+	freezeClock(t, time.Date(year, 12, 20, 12, 0, 0, 0, time.Local))
+
+	division := &fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, DateRange: "05/01 - 11/01", Matches: []*fixtures.Match{
+				{ID: 1, HomePlayer: "herchu", AwayPlayer: "webbi"},
+			}},
+		},
+	}
+	model := NewFixtureModel(division)
+	model.handleCreateTournament()
+
+	if model.dateTimePicker == nil {
+		t.Fatal("Expected the datetime picker to open")
+	}
+
+	// A January round seen in December starts in the coming year
+	want := time.Date(year+1, 1, 5, 0, 0, 0, 0, time.Local).Format("2006-01-02")
+	if got := model.dateTimePicker.GetSelectedTime(); got.Format("2006-01-02") != want {
+		t.Errorf("Expected picker to open on %s, got %s", want, got)
+	}
+}
+
+func TestFixtureModel_CreateTournament_PickerKeepsTodayOnUnreadableRange(t *testing.T) {
+	division := &fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, DateRange: "TBD", Matches: []*fixtures.Match{{ID: 1, HomePlayer: "herchu", AwayPlayer: "webbi"}}},
+		},
+	}
+	model := NewFixtureModel(division)
+	model.handleCreateTournament()
+
+	if got := model.dateTimePicker.GetSelectedTime(); got.Format("2006-01-02") != time.Now().Format("2006-01-02") {
+		t.Errorf("Expected picker to stay on today, got %s", got)
+	}
+}
+
+// pickerNavigatedMsg stands in for a message the picker could send on a navigation key
+type pickerNavigatedMsg struct{ day int }
+
+func TestConfirmOnQuit(t *testing.T) {
+	if confirmOnQuit(nil) != nil {
+		t.Error("Expected no command when the picker returns none")
+	}
+
+	navigated := pickerNavigatedMsg{day: 12}
+	msg := confirmOnQuit(func() tea.Msg { return navigated })()
+	if got, ok := msg.(pickerNavigatedMsg); !ok || got != navigated {
+		t.Errorf("Expected navigation message to pass through intact, got %#v", msg)
+	}
+
+	if _, ok := confirmOnQuit(tea.Quit)().(dateTimePickerConfirmedMsg); !ok {
+		t.Error("Expected tea.Quit to become the picker confirmation")
+	}
+
+	batch, ok := confirmOnQuit(tea.Batch(tea.Quit, func() tea.Msg { return navigated }))().(tea.BatchMsg)
+	if !ok || len(batch) != 2 {
+		t.Fatalf("Expected a batch of two commands, got %#v", batch)
+	}
+	if _, ok := batch[0]().(dateTimePickerConfirmedMsg); !ok {
+		t.Error("Expected tea.Quit inside a batch to become the picker confirmation")
+	}
+	if got := batch[1](); got != navigated {
+		t.Errorf("Expected navigation message inside a batch to pass through intact, got %#v", got)
+	}
+}
+
+func TestDateTimePickerModel_NavigationKeysNeverConfirm(t *testing.T) {
+	picker := NewDateTimePickerModel("player1", "player2", "Elite", 1, 15, 15, time.Time{})
+
+	for _, keyType := range []tea.KeyType{tea.KeyUp, tea.KeyDown, tea.KeyLeft, tea.KeyRight, tea.KeyTab} {
+		_, cmd := picker.Update(tea.KeyMsg{Type: keyType})
+		if cmd == nil {
+			continue
+		}
+		if _, ok := cmd().(dateTimePickerConfirmedMsg); ok {
+			t.Errorf("Expected %s not to confirm the picker", keyType)
+		}
+	}
+
+	if picker.IsConfirmed() {
+		t.Error("Expected navigation keys to leave the picker unconfirmed")
+	}
+}
+
+func TestDateTimePickerModel_CycleTimezone(t *testing.T) {
+	buenosAires, err := time.LoadLocation("America/Argentina/Buenos_Aires")
+	if err != nil {
+		t.Skipf("timezone database unavailable: %v", err)
+	}
+
+	picker := NewDateTimePickerModel("herchu", "webbi", "Elite", 1, 15, 15, time.Time{})
+	local := picker.GetSelectedTime()
+
+	picker.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'t'}})
+	argentina := picker.GetSelectedTime()
+	if argentina.Location().String() != buenosAires.String() {
+		t.Fatalf("Expected Buenos Aires after one press, got %s", argentina.Location())
+	}
+	if _, offset := argentina.Zone(); offset != -3*3600 {
+		t.Errorf("Expected UTC-3 offset, got %d seconds", offset)
+	}
+	if !strings.Contains(picker.View(), "Timezone: America/Argentina/Buenos_Aires (UTC-3)") {
+		t.Errorf("Expected view to show the chosen timezone, got:\n%s", picker.View())
+	}
+
+	picker.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'t'}})
+	utc := picker.GetSelectedTime()
+	if _, offset := utc.Zone(); offset != 0 {
+		t.Errorf("Expected UTC offset 0 after a second press, got %d seconds", offset)
+	}
+	if utc.Sub(argentina) != -3*time.Hour {
+		t.Errorf("Expected the same wall clock to be 3 hours earlier in UTC, got %s vs %s", utc, argentina)
+	}
+	if utc.Format("2006-01-02 15:04") != local.Format("2006-01-02 15:04") {
+		t.Errorf("Expected the wall clock to be kept, got %s instead of %s", utc, local)
+	}
+
+	picker.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'t'}})
+	if got := picker.GetSelectedTime().Location(); got != local.Location() {
+		t.Errorf("Expected to cycle back to %s, got %s", local.Location(), got)
+	}
+}
+
+func TestDateTimePickerModel_SelectedMsgCarriesTimezone(t *testing.T) {
+	if _, err := time.LoadLocation("America/Argentina/Buenos_Aires"); err != nil {
+		t.Skipf("timezone database unavailable: %v", err)
+	}
+
+	picker := NewDateTimePickerModel("herchu", "webbi", "Elite", 1, 15, 15, time.Time{})
+	picker.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'t'}})
+
+	_, cmd := picker.Update(dateTimePickerConfirmedMsg{})
+	selected, ok := cmd().(DateTimeSelectedMsg)
+	if !ok {
+		t.Fatal("Expected DateTimeSelectedMsg after confirming")
+	}
+	if selected.DateTime.Location().String() != "America/Argentina/Buenos_Aires" {
+		t.Errorf("Expected the selected time in Buenos Aires, got %s", selected.DateTime.Location())
+	}
+
+	date, hour := picker.FormatForBGA()
+	if date != selected.DateTime.Format("2006-01-02") || hour != selected.DateTime.Format("15:04") {
+		t.Errorf("Expected BGA format of the Buenos Aires wall clock, got %s %s", date, hour)
+	}
+
+	confirmation := NewTournamentConfirmationModel("herchu", "webbi", "Elite", 1, 15, 15, selected.DateTime)
+	if view := confirmation.View(); !strings.Contains(view, "America/Argentina/Buenos_Aires") {
+		t.Errorf("Expected confirmation to show the chosen timezone, got:\n%s", view)
+	}
+}
+
+func TestDateTimePickerModel_StepIndicator(t *testing.T) {
+	picker := NewDateTimePickerModel("herchu", "webbi", "Elite", 1, 15, 15, time.Now().AddDate(0, 0, 3))
+
+	if view := picker.View(); !strings.Contains(view, "Step 1/2: Select date — press Enter") {
+		t.Errorf("Expected the date step first, got:\n%s", view)
+	}
+
+	// First Enter moves to the time without confirming
+	_, cmd := picker.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd != nil {
+		t.Errorf("Expected no command after the first Enter, got %v", cmd)
+	}
+	view := picker.View()
+	if !strings.Contains(view, "Step 2/2: Select time — press Enter to confirm") {
+		t.Errorf("Expected the time step after the first Enter, got:\n%s", view)
+	}
+	if strings.Contains(view, "Step 1/2") {
+		t.Error("Expected the date step to be gone after the first Enter")
+	}
+
+	// Delete goes back to the date
+	picker.Update(tea.KeyMsg{Type: tea.KeyDelete})
+	if view := picker.View(); !strings.Contains(view, "Step 1/2") {
+		t.Errorf("Expected Delete to go back to the date step, got:\n%s", view)
+	}
+}
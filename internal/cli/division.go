@@ -2,6 +2,11 @@ package cli
 
 import (
 	"fmt"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"carca-cli/internal/fixtures"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
@@ -18,40 +23,145 @@ type BackToMenuMsg struct{}
 
 // DivisionModel represents the division selection TUI state
 type DivisionModel struct {
-	style     lipgloss.Style
-	divisions []string
-	filenames []string
-	cursor    int
+	style          lipgloss.Style
+	divisions      []string
+	filenames      []string
+	progress       []divisionProgress
+	positions      []int
+	cursor         int
+	sortByProgress bool
+}
+
+// divisionProgress holds the played/total match counts of a division fixture
+type divisionProgress struct {
+	played int
+	total  int
+	loaded bool
+}
+
+// completion returns the fraction of played matches, or 1 when the fixture is unavailable
+// so that unreadable divisions sort after the ones needing attention
+func (p divisionProgress) completion() float64 {
+	if !p.loaded || p.total == 0 {
+		return 1
+	}
+
+	return float64(p.played) / float64(p.total)
+}
+
+// label describes the progress next to the division name, e.g. "(12/30 played, 40%)"
+func (p divisionProgress) label() string {
+	switch {
+	case !p.loaded:
+		return "(unavailable)"
+	case p.total == 0:
+		return "(no matches)"
+	}
+
+	return fmt.Sprintf("(%d/%d played, %.0f%%)", p.played, p.total, p.completion()*100)
+}
+
+// fixtureDataDir is where the fixture CSV files of every division are looked up
+const fixtureDataDir = "data"
+
+// NewDivisionModel creates a division selection model listing the fixture files found in the data directory
+// The files are read with opts to count their played matches
+func NewDivisionModel(opts ...fixtures.ParseOption) *DivisionModel {
+	return newDivisionModelFromDir(fixtureDataDir, opts...)
+}
+
+// newDivisionModelFromDir lists the "* - *-Fixture.csv" files of dir sorted by name,
+// falling back to the known divisions when there are none or the directory cannot be read
+func newDivisionModelFromDir(dir string, opts ...fixtures.ParseOption) *DivisionModel {
+	filenames, err := filepath.Glob(filepath.Join(dir, "* - *-Fixture.csv"))
+	if err != nil || len(filenames) == 0 {
+		return newDivisionModel(
+			[]string{
+				"Elite",
+				"Platinum A",
+				"Platinum B",
+				"Oro A",
+				"Oro B",
+				"Oro C",
+				"Oro D",
+			},
+			[]string{
+				"data/Liga Argentina - 1° Temporada - E-Fixture.csv",
+				"data/Liga Argentina - 1° Temporada - P.A-Fixture.csv",
+				"data/Liga Argentina - 1° Temporada - P.B-Fixture.csv",
+				"data/Liga Argentina - 1° Temporada - O.A-Fixture.csv",
+				"data/Liga Argentina - 1° Temporada - O.B-Fixture.csv",
+				"data/Liga Argentina - 1° Temporada - O.C-Fixture.csv",
+				"data/Liga Argentina - 1° Temporada - O.D-Fixture.csv",
+			},
+			opts...,
+		)
+	}
+
+	divisions := make([]string, len(filenames))
+	for i, filename := range filenames {
+		divisions[i] = fixtures.DivisionNameFromFilename(filepath.Base(filename))
+	}
+
+	return newDivisionModel(divisions, filenames, opts...)
 }
 
-// NewDivisionModel creates a new division selection model
-func NewDivisionModel() *DivisionModel {
+// newDivisionModel creates a division selection model for the given divisions and fixture files
+func newDivisionModel(divisions, filenames []string, opts ...fixtures.ParseOption) *DivisionModel {
+	positions := make([]int, len(divisions))
+	for i := range positions {
+		positions[i] = i
+	}
+
 	return &DivisionModel{
-		divisions: []string{
-			"Elite",
-			"Platinum A",
-			"Platinum B",
-			"Oro A",
-			"Oro B",
-			"Oro C",
-			"Oro D",
-		},
-		filenames: []string{
-			"data/Liga Argentina - 1° Temporada - E-Fixture.csv",
-			"data/Liga Argentina - 1° Temporada - P.A-Fixture.csv",
-			"data/Liga Argentina - 1° Temporada - P.B-Fixture.csv",
-			"data/Liga Argentina - 1° Temporada - O.A-Fixture.csv",
-			"data/Liga Argentina - 1° Temporada - O.B-Fixture.csv",
-			"data/Liga Argentina - 1° Temporada - O.C-Fixture.csv",
-			"data/Liga Argentina - 1° Temporada - O.D-Fixture.csv",
-		},
-		cursor: 0,
+		divisions: divisions,
+		filenames: filenames,
+		progress:  loadDivisionProgress(filenames, opts...),
+		positions: positions,
+		cursor:    0,
 		style: lipgloss.NewStyle().
 			Foreground(lipgloss.Color("#7D56F4")).
 			Bold(true),
 	}
 }
 
+// loadDivisionProgress parses every fixture file concurrently and counts played matches
+func loadDivisionProgress(filenames []string, opts ...fixtures.ParseOption) []divisionProgress {
+	progress := make([]divisionProgress, len(filenames))
+
+	var wg sync.WaitGroup
+
+	for i, filename := range filenames {
+		wg.Add(1)
+
+		go func(i int, filename string) {
+			defer wg.Done()
+
+			division, err := fixtures.ParseFixtureFile(filename, opts...)
+			if err != nil {
+				return
+			}
+
+			p := divisionProgress{loaded: true}
+
+			for _, round := range division.Rounds {
+				for _, match := range round.Matches {
+					p.total++
+					if match.Played {
+						p.played++
+					}
+				}
+			}
+
+			progress[i] = p
+		}(i, filename)
+	}
+
+	wg.Wait()
+
+	return progress
+}
+
 // Init initializes the division model (required by Bubble Tea)
 func (m *DivisionModel) Init() tea.Cmd {
 	return nil
@@ -106,6 +216,8 @@ func (m *DivisionModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				if m.cursor < 0 {
 					m.cursor = len(m.divisions) - 1
 				}
+			case "s":
+				m.toggleProgressSort()
 			}
 		}
 	}
@@ -122,7 +234,17 @@ func (m *DivisionModel) View() string {
 
 	s := fmt.Sprintf("\n%s\n\n%s\n\n", title, subtitle)
 
+	if m.sortByProgress {
+		s += lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#626262")).
+			Render("Sorted by progress (least complete first)") + "\n\n"
+	}
+
 	for i, division := range m.divisions {
+		if i < len(m.progress) {
+			division += " " + m.progress[i].label()
+		}
+
 		cursor := " "
 		if m.cursor == i {
 			cursor = ">"
@@ -135,7 +257,7 @@ func (m *DivisionModel) View() string {
 		s += fmt.Sprintf("%s %s\n", cursor, division)
 	}
 
-	s += "\n\nPress enter to select, esc/q to go back, ↑/↓ or j/k to navigate.\n"
+	s += "\n\nPress enter to select, esc/q to go back, ↑/↓ or j/k to navigate, s to sort by progress.\n"
 
 	return s
 }
@@ -157,3 +279,36 @@ func (m *DivisionModel) GetSelectedFilename() string {
 
 	return ""
 }
+
+// toggleProgressSort switches between the fixed division order and least-complete-first
+func (m *DivisionModel) toggleProgressSort() {
+	m.sortByProgress = !m.sortByProgress
+
+	order := make([]int, len(m.divisions))
+	for i := range order {
+		order[i] = i
+	}
+
+	sort.SliceStable(order, func(a, b int) bool {
+		if m.sortByProgress {
+			return m.progress[order[a]].completion() < m.progress[order[b]].completion()
+		}
+
+		return m.positions[order[a]] < m.positions[order[b]]
+	})
+
+	divisions := make([]string, len(order))
+	filenames := make([]string, len(order))
+	progress := make([]divisionProgress, len(order))
+	positions := make([]int, len(order))
+
+	for i, idx := range order {
+		divisions[i] = m.divisions[idx]
+		filenames[i] = m.filenames[idx]
+		progress[i] = m.progress[idx]
+		positions[i] = m.positions[idx]
+	}
+
+	m.divisions, m.filenames, m.progress, m.positions = divisions, filenames, progress, positions
+	m.cursor = 0
+}
@@ -1,9 +1,14 @@
 package cli
 
 import (
+	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
+	"carca-cli/internal/fixtures"
+
 	tea "github.com/charmbracelet/bubbletea"
 )
 
@@ -254,3 +259,124 @@ func TestDivisionModel_Update_VimNavigation_WrapAround(t *testing.T) {
 		t.Errorf("Expected cursor to wrap to %d with 'k', got %d", len(model.divisions)-1, model.cursor)
 	}
 }
+
+func TestDivisionModel_SortByProgress(t *testing.T) {
+	dir := t.TempDir()
+
+	complete := filepath.Join(dir, "Liga Argentina - 1° Temporada - E-Fixture.csv")
+	behind := filepath.Join(dir, "Liga Argentina - 1° Temporada - O.D-Fixture.csv")
+
+	completeCSV := `Duelo,Fecha 1,,,,11/08 - 17/08,Link,,¿Se jugó?,¿Ganó Local?,¿Ganó Visita?
+1,herchu,2,1,Lord Trooper,12/08 - 09:30,https://boardgamearena.com/tournament?id=423761,,1,1,0
+2,webbi,2,0,alehrosario,13/08 - 22:00,https://boardgamearena.com/tournament?id=423630,,1,1,0
+`
+	behindCSV := `Duelo,Fecha 1,,,,11/08 - 17/08,Link,,¿Se jugó?,¿Ganó Local?,¿Ganó Visita?
+1,player1,2,0,player2,12/08 - 09:30,https://boardgamearena.com/tournament?id=423762,,1,1,0
+2,player3,0,0,player4,,,,0,0,0
+`
+
+	if err := os.WriteFile(complete, []byte(completeCSV), 0644); err != nil {
+		t.Fatalf("Failed to write fixture: %v", err)
+	}
+	if err := os.WriteFile(behind, []byte(behindCSV), 0644); err != nil {
+		t.Fatalf("Failed to write fixture: %v", err)
+	}
+
+	model := newDivisionModel([]string{"Elite", "Oro D"}, []string{complete, behind})
+
+	// Default order is kept until the sort is toggled
+	if model.GetSelectedDivision() != "Elite" {
+		t.Fatalf("Expected default order to start with Elite, got %s", model.GetSelectedDivision())
+	}
+
+	_, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}})
+
+	if !model.sortByProgress {
+		t.Fatal("Expected progress sort to be enabled")
+	}
+	if model.divisions[0] != "Oro D" {
+		t.Errorf("Expected least complete division first, got %v", model.divisions)
+	}
+	if model.GetSelectedFilename() != behind {
+		t.Errorf("Expected filename to follow the sorted division, got %s", model.GetSelectedFilename())
+	}
+	if !strings.Contains(model.View(), "Oro D (1/2 played, 50%)") {
+		t.Errorf("Expected view to show completion percentage, got: %s", model.View())
+	}
+
+	// Toggling again restores the fixed order
+	_, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}})
+
+	if model.divisions[0] != "Elite" || model.filenames[0] != complete {
+		t.Errorf("Expected default order to be restored, got %v", model.divisions)
+	}
+}
+
+func TestDivisionModel_ListsFixtureFilesFromDir(t *testing.T) {
+	dir := t.TempDir()
+
+	fixtureCSV := `Duelo,Fecha 1,,,,11/08 - 17/08,Link,,¿Se jugó?,¿Ganó Local?,¿Ganó Visita?
+1,herchu,2,1,Lord Trooper,12/08 - 09:30,,,1,1,0
+`
+	for _, name := range []string{
+		"Liga Argentina - 2° Temporada - P.A-Fixture.csv",
+		"Liga Argentina - 2° Temporada - E-Fixture.csv",
+		"notes.csv",
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(fixtureCSV), 0644); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+
+	model := newDivisionModelFromDir(dir)
+
+	if strings.Join(model.divisions, ",") != "E,P.A" {
+		t.Fatalf("Expected the two fixture files sorted by name, got %v", model.divisions)
+	}
+	if want := filepath.Join(dir, "Liga Argentina - 2° Temporada - E-Fixture.csv"); model.filenames[0] != want {
+		t.Errorf("Expected filename %q, got %q", want, model.filenames[0])
+	}
+	if view := model.View(); !strings.Contains(view, "E") || !strings.Contains(view, "P.A") {
+		t.Errorf("Expected both divisions in the view, got:\n%s", view)
+	}
+}
+
+func TestDivisionModel_EmptyDirFallsBackToKnownDivisions(t *testing.T) {
+	for _, dir := range []string{t.TempDir(), filepath.Join(t.TempDir(), "missing")} {
+		model := newDivisionModelFromDir(dir)
+
+		if len(model.divisions) != 7 || model.divisions[0] != "Elite" {
+			t.Errorf("Expected the known divisions for %s, got %v", dir, model.divisions)
+		}
+	}
+}
+
+func TestDivisionModel_View_ShowsPlayedCounts(t *testing.T) {
+	model := newDivisionModel(
+		[]string{"Elite", "Oro D"},
+		[]string{"../../data/Liga Argentina - 1° Temporada - E-Fixture.csv", filepath.Join(t.TempDir(), "missing.csv")},
+	)
+
+	division, err := fixtures.ParseFixtureFile("../../data/Liga Argentina - 1° Temporada - E-Fixture.csv")
+	if err != nil {
+		t.Fatalf("Failed to parse the Elite fixture: %v", err)
+	}
+
+	var played, total int
+	for _, round := range division.Rounds {
+		for _, match := range round.Matches {
+			total++
+			if match.Played {
+				played++
+			}
+		}
+	}
+
+	view := model.View()
+	if want := fmt.Sprintf("Elite (%d/%d played, ", played, total); !strings.Contains(view, want) {
+		t.Errorf("Expected %q in the view, got:\n%s", want, view)
+	}
+	if !strings.Contains(view, "Oro D (unavailable)") {
+		t.Errorf("Expected an unreadable fixture to be shown as unavailable, got:\n%s", view)
+	}
+}
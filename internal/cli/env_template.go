@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+)
+
+// EnvTemplate is the .env written by "carca init", with the credentials left for the user to fill in
+const EnvTemplate = `# Board Game Arena credentials used by carca
+# Uncomment both lines and fill in your BGA username and password
+# BGA_USER=
+# BGA_PASS=
+`
+
+// EnvNextSteps tells the user what to do once the .env template is written
+const EnvNextSteps = `Next steps:
+  1. Edit .env and fill in BGA_USER and BGA_PASS
+  2. Keep .env out of version control, it holds your password
+  3. Run carca to start managing tournaments`
+
+// ErrEnvFileExists is returned by InitEnvFile when the file is already there and force is not set
+var ErrEnvFileExists = errors.New(".env already exists, use --force to overwrite it")
+
+// InitEnvFile writes the credentials template to path, readable only by the user
+// An existing file is only replaced when force is set
+func InitEnvFile(path string, force bool) error {
+	flags := os.O_WRONLY | os.O_CREATE | os.O_EXCL
+	if force {
+		flags = os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	}
+
+	file, err := os.OpenFile(path, flags, 0o600)
+	if errors.Is(err, os.ErrExist) {
+		return ErrEnvFileExists
+	}
+	if err != nil {
+		return fmt.Errorf("error creating %s: %w", path, err)
+	}
+	defer file.Close()
+
+	// A forced overwrite keeps the old file's mode, so tighten it as well
+	if err := file.Chmod(0o600); err != nil {
+		return fmt.Errorf("error setting permissions on %s: %w", path, err)
+	}
+
+	if _, err := file.WriteString(EnvTemplate); err != nil {
+		return fmt.Errorf("error writing %s: %w", path, err)
+	}
+
+	return nil
+}
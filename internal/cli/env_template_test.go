@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestInitEnvFile_CreatesTemplate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".env")
+
+	if err := InitEnvFile(path, false); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read .env file: %v", err)
+	}
+	if string(content) != EnvTemplate {
+		t.Errorf("Expected the template, got %q", string(content))
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("Failed to stat .env file: %v", err)
+	}
+	if perm := info.Mode().Perm(); perm != 0o600 {
+		t.Errorf("Expected mode 0600, got %o", perm)
+	}
+}
+
+func TestInitEnvFile_TemplateHasNoActiveCredentials(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".env")
+
+	if err := InitEnvFile(path, false); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	t.Chdir(filepath.Dir(path))
+	user, pass, err := loadFromEnvFile()
+	if err != nil {
+		t.Fatalf("Expected the template to parse, got: %v", err)
+	}
+	if user != "" || pass != "" {
+		t.Errorf("Expected commented placeholders only, got %q/%q", user, pass)
+	}
+}
+
+func TestInitEnvFile_RefusesToOverwrite(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".env")
+	if err := os.WriteFile(path, []byte("BGA_USER=keep\n"), 0o600); err != nil {
+		t.Fatalf("Failed to create .env file: %v", err)
+	}
+
+	err := InitEnvFile(path, false)
+	if !errors.Is(err, ErrEnvFileExists) {
+		t.Fatalf("Expected ErrEnvFileExists, got: %v", err)
+	}
+
+	content, _ := os.ReadFile(path)
+	if string(content) != "BGA_USER=keep\n" {
+		t.Errorf("Expected the existing file to be untouched, got %q", string(content))
+	}
+}
+
+func TestInitEnvFile_ForceOverwrites(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".env")
+	if err := os.WriteFile(path, []byte("BGA_USER=old\nBGA_PASS=old\n"), 0o644); err != nil {
+		t.Fatalf("Failed to create .env file: %v", err)
+	}
+
+	if err := InitEnvFile(path, true); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	content, _ := os.ReadFile(path)
+	if string(content) != EnvTemplate {
+		t.Errorf("Expected the template to replace the file, got %q", string(content))
+	}
+
+	info, _ := os.Stat(path)
+	if perm := info.Mode().Perm(); perm != 0o600 {
+		t.Errorf("Expected mode 0600 after overwrite, got %o", perm)
+	}
+}
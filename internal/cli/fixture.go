@@ -1,14 +1,19 @@
 package cli
 
 import (
+	"context"
+	"errors"
 	"fmt"
-	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
 	"carca-cli/internal/bga"
 	"carca-cli/internal/fixtures"
 
 	"github.com/atotto/clipboard"
+	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/lipgloss/table"
@@ -20,21 +25,60 @@ type FixtureModel struct {
 	bgaClient         bga.APIClient
 	dateTimePicker    *DateTimePickerModel
 	confirmationModel *TournamentConfirmationModel
+	errorModel        *TournamentErrorModel
+	lastAttempt       *createTournamentMsgWithDateTime
+	cancelCreate      context.CancelFunc
+	bulk              *bulkCreation
+	failedBulk        []createTournamentMsgWithDateTime
+	problems          []error
+	batchPending      *bulkCreation
+	statusClearDelay  time.Duration
 	style             lipgloss.Style
+	searchInput       textinput.Model
+	rescheduleInput   textinput.Model
+	spinner           spinner.Model
+	markers           StatusMarkers
+	searchResults     []matchRef
 	statusMessage     string
+	lastLink          string
+	width             int
+	height            int
 	currentRound      int
 	selectedMatch     int
+	searchCursor      int
 	showDatePicker    bool
 	showConfirmation  bool
+	use24Hour         bool
+	isoDates          bool
+	walkoverPrompt    bool
+	resultPrompt      bool
+	searching         bool
+	rescheduling      bool
+	helpCollapsed     bool
+	spinnerActive     bool
+	autoSelectRound   bool
 }
 
+// clipboardWriteAll copies text to the system clipboard, replaced in tests
+var clipboardWriteAll = clipboard.WriteAll
+
+// DefaultStatusClearDelay is how long status messages stay on screen unless configured otherwise
+const DefaultStatusClearDelay = 3 * time.Second
+
 // NewFixtureModel creates a new fixture display model
 func NewFixtureModel(division *fixtures.Division) *FixtureModel {
 	return &FixtureModel{
-		division:      division,
-		currentRound:  0,
-		selectedMatch: 0,
-		statusMessage: "",
+		division:         division,
+		currentRound:     division.CurrentRound(now()),
+		selectedMatch:    0,
+		statusMessage:    "",
+		statusClearDelay: DefaultStatusClearDelay,
+		autoSelectRound:  true,
+		markers:          DefaultStatusMarkers,
+		spinner: spinner.New(
+			spinner.WithSpinner(spinner.Dot),
+			spinner.WithStyle(lipgloss.NewStyle().Foreground(lipgloss.Color("#7D56F4"))),
+		),
 		style: lipgloss.NewStyle().
 			Foreground(lipgloss.Color("#7D56F4")).
 			Bold(true),
@@ -43,6 +87,10 @@ func NewFixtureModel(division *fixtures.Division) *FixtureModel {
 
 // Init initializes the fixture model (required by Bubble Tea)
 func (m *FixtureModel) Init() tea.Cmd {
+	if m.spinnerActive {
+		return m.spinner.Tick
+	}
+
 	return nil
 }
 
@@ -53,6 +101,11 @@ func (m *FixtureModel) SetBGAClient(client bga.APIClient) {
 
 // Update handles messages and updates the model state
 func (m *FixtureModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	// Remember the terminal size whatever screen is showing
+	if size, ok := msg.(tea.WindowSizeMsg); ok {
+		m.SetSize(size.Width, size.Height)
+	}
+
 	// Handle sub-model messages first
 	if model, cmd, handled := m.handleSubModelMessages(msg); handled {
 		return model, cmd
@@ -63,36 +116,37 @@ func (m *FixtureModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m.handleKeyMessages(msg)
 	case clearStatusMsg:
 		m.statusMessage = ""
+	case spinner.TickMsg:
+		return m, m.updateSpinner(msg)
 	case createTournamentMsg:
-		return m.handleCreateTournamentResponse(msg)
+		model, cmd := m.handleCreateTournamentResponse(msg)
+		return model, m.withSpinner(cmd)
 	case createTournamentMsgWithDateTime:
-		return m.handleCreateTournamentWithDateTime(&msg)
+		model, cmd := m.handleCreateTournamentWithDateTime(&msg)
+		return model, m.withSpinner(cmd)
 	case tournamentCreatedMsg:
+		m.spinnerActive = false
 		return m.handleTournamentCreated(msg)
+	case tournamentLaunchedMsg:
+		return m.handleTournamentLaunched(msg)
+	case playersInvitedMsg:
+		return m.handlePlayersInvited(msg)
+	case tournamentStatusMsg:
+		return m.handleTournamentStatus(msg)
 	case DateTimeSelectedMsg:
-		// DateTime selected, show confirmation screen
-		m.showDatePicker = false
-		m.confirmationModel = NewTournamentConfirmationModel(
-			msg.HomePlayer,
-			msg.AwayPlayer,
-			msg.Division,
-			msg.RoundNumber,
-			msg.MatchNumber,
-			msg.MatchID,
-			msg.DateTime,
-		)
-		m.showConfirmation = true
-		return m, nil
+		return m.handleDateTimeSelected(msg)
 	case DateTimePickerCanceledMsg:
 		// DateTime picker canceled
 		m.showDatePicker = false
 		m.statusMessage = "Tournament creation canceled"
-		return m, tea.Tick(time.Second*2, func(time.Time) tea.Msg {
-			return clearStatusMsg{}
-		})
+		return m, m.clearStatus()
 	case TournamentConfirmedMsg:
 		// Confirmation received, proceed with tournament creation
 		m.showConfirmation = false
+		if missingPlayerName(msg.HomePlayer, msg.AwayPlayer) {
+			m.statusMessage = missingPlayerStatus
+			return m, m.clearStatus()
+		}
 		m.statusMessage = fmt.Sprintf("Creating tournament for %s vs %s...",
 			msg.HomePlayer, msg.AwayPlayer)
 
@@ -111,9 +165,11 @@ func (m *FixtureModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Tournament confirmation canceled
 		m.showConfirmation = false
 		m.statusMessage = "Tournament creation canceled"
-		return m, tea.Tick(time.Second*2, func(time.Time) tea.Msg {
-			return clearStatusMsg{}
-		})
+		return m, m.clearStatus()
+	case TournamentErrorDismissedMsg:
+		m.errorModel = nil
+		m.statusMessage = "Tournament creation canceled"
+		return m, m.clearStatus()
 	case EditDateTimeMsg:
 		// Edit datetime - go back to datetime picker with current values
 		m.showConfirmation = false
@@ -126,6 +182,7 @@ func (m *FixtureModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			msg.MatchID,
 			msg.DateTime,
 		)
+		m.dateTimePicker.SetUse24Hour(m.use24Hour)
 		m.showDatePicker = true
 		return m, m.dateTimePicker.Init()
 	}
@@ -165,8 +222,26 @@ type tournamentCreatedMsg struct {
 	success      bool
 }
 
+// tournamentLaunchedMsg is sent when the launch step of a new tournament completes
+type tournamentLaunchedMsg struct {
+	err          error
+	homePlayer   string
+	awayPlayer   string
+	tournamentID int
+}
+
+// playersInvitedMsg is sent when both players have been invited or an invitation failed
+type playersInvitedMsg struct {
+	err          error
+	failedPlayer string
+	tournamentID int
+}
+
 // handleCreateTournamentResponse handles the tournament creation request
 func (m *FixtureModel) handleCreateTournamentResponse(msg createTournamentMsg) (tea.Model, tea.Cmd) {
+	ctx := m.beginCreate()
+	season := m.division.Season
+
 	return m, tea.Cmd(func() tea.Msg {
 		if !m.bgaClient.IsAuthenticated() {
 			// Get credentials and login
@@ -188,11 +263,11 @@ func (m *FixtureModel) handleCreateTournamentResponse(msg createTournamentMsg) (
 				m.bgaClient = bga.NewClient(username, password)
 			}
 
-			err = m.bgaClient.Login()
+			err = m.bgaClient.Login(ctx)
 			if err != nil {
 				return tournamentCreatedMsg{
 					success:  false,
-					error:    fmt.Sprintf("Login failed: %v", err),
+					error:    loginErrorText(err),
 					matchID:  msg.matchID,
 					roundNum: msg.roundNum,
 				}
@@ -201,17 +276,19 @@ func (m *FixtureModel) handleCreateTournamentResponse(msg createTournamentMsg) (
 
 		// Create tournament with division and match information (default scheduling)
 		resp, err := m.bgaClient.CreateSwissTournament(
+			ctx,
 			m.division.Name,
 			msg.homePlayer,
 			msg.awayPlayer,
 			msg.roundNum+1,
 			msg.matchID,
+			bga.WithSeason(season),
 		)
 
 		if err != nil {
 			return tournamentCreatedMsg{
 				success:  false,
-				error:    fmt.Sprintf("Tournament creation failed: %v", err),
+				error:    creationErrorText(err),
 				matchID:  msg.matchID,
 				roundNum: msg.roundNum,
 			}
@@ -236,37 +313,316 @@ func (m *FixtureModel) handleCreateTournamentResponse(msg createTournamentMsg) (
 	})
 }
 
+// beginCreate starts a cancelable context for an in-flight tournament creation
+func (m *FixtureModel) beginCreate() context.Context {
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancelCreate = cancel
+	return ctx
+}
+
+// creationErrorText describes a failed creation, reporting user cancellations plainly
+func creationErrorText(err error) string {
+	if errors.Is(err, context.Canceled) {
+		return "canceled"
+	}
+	if friendly := friendlyErrorText(err); friendly != "" {
+		return "Tournament creation failed: " + friendly
+	}
+	return fmt.Sprintf("Tournament creation failed: %v", err)
+}
+
+// loginErrorText describes a failed login, pointing at the credentials when BGA refused them
+func loginErrorText(err error) string {
+	if friendly := friendlyErrorText(err); friendly != "" {
+		return "Login failed: " + friendly
+	}
+	return fmt.Sprintf("Login failed: %v", err)
+}
+
+// friendlyErrorText explains the bga errors users can act on, or returns "" for any other error
+func friendlyErrorText(err error) string {
+	var validationErr *bga.ValidationError
+
+	switch {
+	case errors.Is(err, bga.ErrInvalidCredentials):
+		return "BGA rejected the username or password, check BGA_USER and BGA_PASS"
+	case errors.Is(err, bga.ErrNotAuthenticated):
+		return "not logged in to BGA, please try again"
+	case errors.Is(err, bga.ErrTournamentNotFound):
+		return "the tournament no longer exists on BGA"
+	case errors.As(err, &validationErr):
+		return validationErr.Message
+	default:
+		return ""
+	}
+}
+
+// validateTournamentLink checks that the link points to the tournament ID the API reported
+func validateTournamentLink(link string, tournamentID int) error {
+	linkID, err := bga.ExtractTournamentID(link)
+	if err != nil {
+		return err
+	}
+
+	if linkID != tournamentID {
+		return fmt.Errorf("link points to tournament %d but API returned %d", linkID, tournamentID)
+	}
+
+	return nil
+}
+
+// endCreate releases the context of the tournament step that just finished
+func (m *FixtureModel) endCreate() {
+	if m.cancelCreate != nil {
+		m.cancelCreate()
+		m.cancelCreate = nil
+	}
+}
+
+// findMatch returns the match with the given ID in a 0-based round, or nil
+func (m *FixtureModel) findMatch(roundNum, matchID int) *fixtures.Match {
+	if roundNum < 0 || roundNum >= len(m.division.Rounds) {
+		return nil
+	}
+
+	for _, match := range m.division.Rounds[roundNum].Matches {
+		if match.ID == matchID {
+			return match
+		}
+	}
+
+	return nil
+}
+
 // handleTournamentCreated handles the tournament creation completion
 func (m *FixtureModel) handleTournamentCreated(msg tournamentCreatedMsg) (tea.Model, tea.Cmd) {
+	m.endCreate()
+
+	attempt := m.lastAttempt
+	m.lastAttempt = nil
+
 	if !msg.success {
 		m.statusMessage = fmt.Sprintf("Tournament creation failed: %s", msg.error)
-	} else {
-		// Update the match with the tournament link
-		if msg.roundNum < len(m.division.Rounds) {
-			round := m.division.Rounds[msg.roundNum]
-			for i, match := range round.Matches {
-				if match.ID == msg.matchID {
-					round.Matches[i].BGALink = msg.link
-					break
-				}
-			}
+
+		// A single creation stops on its own error screen, bulk runs carry on and offer 'R' at the end
+		if m.bulk == nil && attempt != nil && msg.error != creationErrorText(context.Canceled) {
+			m.errorModel = NewTournamentErrorModel(*attempt, msg.error)
+			m.errorModel.SetUse24Hour(m.use24Hour)
+			return m, nil
 		}
 
+		m.recordBulkResult(false)
+		return m, m.afterCreation()
+	}
+
+	if err := validateTournamentLink(msg.link, msg.tournamentID); err != nil {
+		m.statusMessage = fmt.Sprintf("Tournament created but link not saved: %v", err)
+		m.recordBulkResult(false)
+		return m, m.afterCreation()
+	}
+
+	// Update the match with the tournament link
+	match := m.findMatch(msg.roundNum, msg.matchID)
+	if match != nil {
+		match.BGALink = msg.link
+	}
+	m.lastLink = msg.link
+
+	m.recordBulkResult(true)
+
+	if m.bulk != nil {
+		// Links of a bulk run are stored in the fixture rather than copied one by one
+		m.statusMessage = "Tournament created successfully!"
+	} else {
 		m.statusMessage = "Tournament created successfully! Link copied to clipboard."
 
 		// Copy link to clipboard
-		if err := clipboard.WriteAll(msg.link); err != nil {
+		if err := clipboardWriteAll(msg.link); err != nil {
 			m.statusMessage = "Tournament created successfully! (Failed to copy link to clipboard)"
 		}
 	}
 
-	return m, tea.Tick(time.Second*3, func(time.Time) tea.Msg {
+	if match == nil {
+		return m, m.afterCreation()
+	}
+
+	// Keep the link across restarts by writing it back to the fixture file
+	if m.division.Filename != "" {
+		if err := fixtures.WriteDivisionFile(m.division, m.division.Filename); err != nil {
+			m.statusMessage += fmt.Sprintf(" (link not saved: %v)", err)
+		}
+	}
+
+	m.statusMessage += " Launching..."
+
+	// Invite the players as named on the confirmation screen, where a typo in the fixture may have been fixed
+	homePlayer, awayPlayer := match.HomePlayer, match.AwayPlayer
+	if attempt != nil {
+		homePlayer, awayPlayer = attempt.homePlayer, attempt.awayPlayer
+	}
+
+	return m, m.launchTournament(msg.tournamentID, homePlayer, awayPlayer)
+}
+
+// launchTournament opens the created tournament on BGA
+func (m *FixtureModel) launchTournament(tournamentID int, homePlayer, awayPlayer string) tea.Cmd {
+	ctx := m.beginCreate()
+
+	return func() tea.Msg {
+		return tournamentLaunchedMsg{
+			err:          m.bgaClient.LaunchTournament(ctx, tournamentID),
+			tournamentID: tournamentID,
+			homePlayer:   homePlayer,
+			awayPlayer:   awayPlayer,
+		}
+	}
+}
+
+// handleTournamentLaunched invites both players once the tournament is open
+func (m *FixtureModel) handleTournamentLaunched(msg tournamentLaunchedMsg) (tea.Model, tea.Cmd) {
+	m.endCreate()
+
+	if msg.err != nil {
+		m.statusMessage = fmt.Sprintf("Tournament created but launch failed: %v", msg.err)
+		return m, m.afterCreation()
+	}
+
+	m.statusMessage = fmt.Sprintf("Tournament launched, inviting %s and %s...", msg.homePlayer, msg.awayPlayer)
+	ctx := m.beginCreate()
+
+	return m, func() tea.Msg {
+		for _, player := range []string{msg.homePlayer, msg.awayPlayer} {
+			playerID, err := m.bgaClient.ResolvePlayerID(ctx, player)
+			if err == nil {
+				err = m.bgaClient.InvitePlayer(ctx, msg.tournamentID, playerID)
+			}
+			if err != nil {
+				return playersInvitedMsg{err: err, failedPlayer: player, tournamentID: msg.tournamentID}
+			}
+		}
+		return playersInvitedMsg{tournamentID: msg.tournamentID}
+	}
+}
+
+// handlePlayersInvited reports the outcome of the invitation step
+func (m *FixtureModel) handlePlayersInvited(msg playersInvitedMsg) (tea.Model, tea.Cmd) {
+	m.endCreate()
+
+	if msg.err != nil {
+		m.statusMessage = fmt.Sprintf("Tournament launched but inviting %s failed: %v", msg.failedPlayer, msg.err)
+	} else {
+		m.statusMessage = fmt.Sprintf("Tournament %d launched and both players invited!", msg.tournamentID)
+	}
+
+	return m, m.afterCreation()
+}
+
+// clearAfter clears the status message once the delay has passed
+func clearAfter(delay time.Duration) tea.Cmd {
+	return tea.Tick(delay, func(time.Time) tea.Msg {
 		return clearStatusMsg{}
 	})
 }
 
+// clearStatus schedules clearing the status message after the configured delay
+func (m *FixtureModel) clearStatus() tea.Cmd {
+	return clearAfter(m.statusClearDelay)
+}
+
+// SetStatusClearDelay sets how long status messages stay on screen
+func (m *FixtureModel) SetStatusClearDelay(delay time.Duration) {
+	if delay > 0 {
+		m.statusClearDelay = delay
+	}
+}
+
+// SetUse24Hour chooses between 24-hour and 12-hour times in the picker and confirmation screens
+func (m *FixtureModel) SetUse24Hour(use24Hour bool) {
+	m.use24Hour = use24Hour
+}
+
+// SetSize sets the terminal size the fixture table has to fit in, zero meaning unknown
+func (m *FixtureModel) SetSize(width, height int) {
+	m.width, m.height = width, height
+}
+
+// SetValidationProblems sets the fixture problems warned about above the matches
+func (m *FixtureModel) SetValidationProblems(problems []error) {
+	m.problems = problems
+}
+
+// SetHelpCollapsed chooses whether the key help footer starts collapsed to a single line
+func (m *FixtureModel) SetHelpCollapsed(collapsed bool) {
+	m.helpCollapsed = collapsed
+}
+
+// SetAutoSelectRound chooses whether the fixture opens on the round whose dates contain today or on the first one
+func (m *FixtureModel) SetAutoSelectRound(enabled bool) {
+	m.autoSelectRound = enabled
+	m.currentRound, m.selectedMatch = 0, 0
+
+	if enabled {
+		m.currentRound = m.division.CurrentRound(now())
+	}
+}
+
+// SetStatusMarkers sets the symbols of the PLAYED column, keeping the current ones when any is missing
+func (m *FixtureModel) SetStatusMarkers(markers StatusMarkers) {
+	if markers.Validate() == nil {
+		m.markers = markers
+	}
+}
+
+// SetISODates chooses whether parseable match dates are shown as "2006-01-02 15:04" in the table
+func (m *FixtureModel) SetISODates(isoDates bool) {
+	m.isoDates = isoDates
+}
+
+// toggleISODates handles 'D' key to switch the DATE column between ISO and fixture formats
+func (m *FixtureModel) toggleISODates() (tea.Model, tea.Cmd) {
+	m.isoDates = !m.isoDates
+	if m.isoDates {
+		m.statusMessage = "Showing dates in ISO format"
+	} else {
+		m.statusMessage = "Showing dates as written in the fixture"
+	}
+	return m, m.clearStatus()
+}
+
+// displayDateTime returns the match datetime as shown in the DATE column
+// Unparseable values are shown verbatim even when ISO dates are on
+func (m *FixtureModel) displayDateTime(match *fixtures.Match) string {
+	if m.isoDates {
+		if scheduled, err := match.ScheduledTime(now()); err == nil {
+			return scheduled.Format("2006-01-02 15:04")
+		}
+	}
+	return match.DateTime
+}
+
+// toggleTimeFormat handles 'T' key to switch between 24-hour and 12-hour time display
+func (m *FixtureModel) toggleTimeFormat() (tea.Model, tea.Cmd) {
+	m.use24Hour = !m.use24Hour
+	if m.use24Hour {
+		m.statusMessage = "Showing times in 24-hour format"
+	} else {
+		m.statusMessage = "Showing times in 12-hour format"
+	}
+	return m, m.clearStatus()
+}
+
 // handleCreateTournamentWithDateTime handles tournament creation with specific datetime
 func (m *FixtureModel) handleCreateTournamentWithDateTime(msg *createTournamentMsgWithDateTime) (tea.Model, tea.Cmd) {
+	if m.errorModel != nil {
+		m.errorModel = nil
+		m.statusMessage = fmt.Sprintf("Retrying tournament for %s vs %s...", msg.homePlayer, msg.awayPlayer)
+	}
+
+	ctx := m.beginCreate()
+	attempt := *msg
+	m.lastAttempt = &attempt
+	season := m.division.Season
+
 	return m, tea.Cmd(func() tea.Msg {
 		if !m.bgaClient.IsAuthenticated() {
 			// Get credentials and login
@@ -288,11 +644,11 @@ func (m *FixtureModel) handleCreateTournamentWithDateTime(msg *createTournamentM
 				m.bgaClient = bga.NewClient(username, password)
 			}
 
-			err = m.bgaClient.Login()
+			err = m.bgaClient.Login(ctx)
 			if err != nil {
 				return tournamentCreatedMsg{
 					success:  false,
-					error:    fmt.Sprintf("Login failed: %v", err),
+					error:    loginErrorText(err),
 					matchID:  msg.matchID,
 					roundNum: msg.roundNum,
 				}
@@ -301,18 +657,20 @@ func (m *FixtureModel) handleCreateTournamentWithDateTime(msg *createTournamentM
 
 		// Create tournament with specified datetime
 		resp, err := m.bgaClient.CreateSwissTournamentWithDateTime(
+			ctx,
 			msg.division,
 			msg.homePlayer,
 			msg.awayPlayer,
 			msg.roundNum+1,
 			msg.matchNumber,
 			msg.dateTime,
+			bga.WithSeason(season),
 		)
 
 		if err != nil {
 			return tournamentCreatedMsg{
 				success:  false,
-				error:    fmt.Sprintf("Tournament creation failed: %v", err),
+				error:    creationErrorText(err),
 				matchID:  msg.matchID,
 				roundNum: msg.roundNum,
 			}
@@ -339,6 +697,10 @@ func (m *FixtureModel) handleCreateTournamentWithDateTime(msg *createTournamentM
 
 // View renders the current state of the fixture display
 func (m *FixtureModel) View() string {
+	if m.errorModel != nil {
+		return m.errorModel.View()
+	}
+
 	// Show confirmation screen if active
 	if m.showConfirmation && m.confirmationModel != nil {
 		return m.confirmationModel.View()
@@ -348,6 +710,15 @@ func (m *FixtureModel) View() string {
 	if m.showDatePicker && m.dateTimePicker != nil {
 		return m.dateTimePicker.View()
 	}
+
+	if m.searching {
+		return m.renderSearch()
+	}
+
+	if m.batchPending != nil {
+		return m.renderBatchConfirmation()
+	}
+
 	if len(m.division.Rounds) == 0 {
 		return "No fixtures available for this division.\n\nPress esc/q to go back.\n"
 	}
@@ -362,7 +733,16 @@ func (m *FixtureModel) View() string {
 		Foreground(lipgloss.Color("#FAFAFA")).
 		Render(fmt.Sprintf("Date Range: %s", currentRound.DateRange))
 
-	s := fmt.Sprintf("\n%s\n%s\n\n", title, dateRange)
+	s := fmt.Sprintf("\n%s\n%s\n", title, dateRange)
+	if currentRound.AllTournamentsCreated() {
+		s += lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#50C878")).
+			Render("✓ All tournaments created for this round") + "\n"
+	}
+	if len(m.problems) > 0 {
+		s += m.renderProblems() + "\n"
+	}
+	s += "\n"
 
 	// Display matches in table format
 	if len(currentRound.Matches) == 0 {
@@ -376,102 +756,228 @@ func (m *FixtureModel) View() string {
 	// Navigation info
 	s += fmt.Sprintf("\n\nRound %d of %d", m.currentRound+1, len(m.division.Rounds))
 
+	// Per-game results of the selected match, when the fixture records them
+	if match := m.selectedFixtureMatch(); match != nil && len(match.GameScores) > 0 {
+		s += fmt.Sprintf("\nDuelo %d games: %s", match.ID, strings.Join(match.GameScores, ", "))
+	}
+
 	// Show status message if present
 	if m.statusMessage != "" {
-		s += "\n" + lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#50C878")).
-			Bold(true).
-			Render(m.statusMessage)
+		s += "\n" + m.renderStatus()
 	}
 
-	s += "\n\nPress ←/→, h/l, or PgUp/PgDown to navigate rounds"
-	s += "\nPress ↑/↓, j/k to select matches, Enter to copy link"
-	s += "\nPress 'c' to create tournament for unplayed matches"
-	s += "\nPress esc/q to go back.\n"
+	if m.rescheduling {
+		s += "\n" + m.renderReschedulePrompt()
+	}
+
+	s += "\n\n" + wrapToWidth(m.helpFooter(), m.width) + "\n"
 
 	return s
 }
 
-// formatMatchesTable formats matches in a table format
+// maxShownProblems is how many fixture problems the warning banner lists before summarizing the rest
+const maxShownProblems = 3
+
+// renderProblems warns that the fixture file has problems, listing the first few
+func (m *FixtureModel) renderProblems() string {
+	shown := make([]string, 0, maxShownProblems)
+	for _, problem := range m.problems[:min(len(m.problems), maxShownProblems)] {
+		shown = append(shown, problem.Error())
+	}
+
+	banner := fmt.Sprintf("⚠ Fixture problems: %s", strings.Join(shown, "; "))
+	if hidden := len(m.problems) - len(shown); hidden > 0 {
+		banner += fmt.Sprintf(" (and %d more, see the log with L)", hidden)
+	}
+
+	return lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#FF6B6B")).
+		Render(wrapToWidth(banner, m.width))
+}
+
+// helpFooter lists the fixture keys, or just how to show them when the footer is collapsed
+func (m *FixtureModel) helpFooter() string {
+	var retry string
+	if len(m.failedBulk) > 0 {
+		retry = fmt.Sprintf("\nPress 'R' to retry failed (%d)", len(m.failedBulk))
+	}
+
+	if m.helpCollapsed {
+		return "Press ? for help" + retry
+	}
+
+	help := "Press ←/→, h/l, or PgUp/PgDown to navigate rounds"
+	help += "\nPress ↑/↓, j/k to select matches, Enter to copy link, o to open it, r to refresh its status"
+	help += "\nPress Y to re-copy the last created or copied link, y to copy its tournament ID, " +
+		"s to copy a played match's result, / to search matches by player, i to export the calendar"
+	help += "\nPress 'c' to create tournament for unplayed matches, 'a' for all scheduled matches, " +
+		"'b' for the whole round, 'S' to shift the round's dates"
+	help += retry
+	help += "\nPress 'u' to jump to the soonest match needing a tournament, 'n' to the next round to schedule, " +
+		"'w' to record a walkover, 'e' to enter a result, ? to hide this help"
+	help += "\nPress D to toggle ISO dates, T to toggle 12h/24h times, L to view the session log, " +
+		"esc/q to go back, H to go home."
+
+	return help
+}
+
+// wrapToWidth word-wraps text to the terminal width, leaving it untouched while the width is unknown
+func wrapToWidth(text string, width int) string {
+	if width <= 0 {
+		return text
+	}
+
+	return lipgloss.NewStyle().Width(width).Render(text)
+}
+
+// matchesTableLayout picks the optional parts of the matches table
+type matchesTableLayout struct {
+	padNames         bool
+	showDate         bool
+	showTournamentID bool
+}
+
+// matchesTableLayouts go from the full table to the narrowest one, dropping TOURNAMENT_ID, then DATE,
+// then the extra padding around player names
+var matchesTableLayouts = []matchesTableLayout{
+	{padNames: true, showDate: true, showTournamentID: true},
+	{padNames: true, showDate: true},
+	{padNames: true},
+	{},
+}
+
+// formatMatchesTable formats matches in a table format, as wide as the terminal allows
 func (m *FixtureModel) formatMatchesTable(matches []*fixtures.Match) string {
-	maxPlayerWidth := m.calculateMaxPlayerNameWidth()
-	maxDateWidth := m.calculateMaxDateWidth()
-	maxTournamentIDWidth := m.calculateMaxTournamentIDWidth()
+	var rendered string
+
+	for _, layout := range matchesTableLayouts {
+		rendered = m.renderMatchesTable(matches, layout)
+		if m.width <= 0 || lipgloss.Width(rendered) <= m.width {
+			break
+		}
+	}
+
+	return rendered
+}
+
+// renderMatchesTable renders the matches table with the columns of the given layout
+func (m *FixtureModel) renderMatchesTable(matches []*fixtures.Match, layout matchesTableLayout) string {
+	headers := []string{"DUELO", "PLAYED", "HOME", "AWAY", "RESULT"}
+	if layout.showDate {
+		headers = append(headers, "DATE")
+	}
+	headers = append(headers, "STATUS")
+	if layout.showTournamentID {
+		headers = append(headers, "TOURNAMENT_ID")
+	}
 
 	t := table.New().
 		Border(lipgloss.NormalBorder()).
 		BorderStyle(lipgloss.NewStyle().Foreground(lipgloss.Color("99"))).
 		StyleFunc(func(row, col int) lipgloss.Style {
 			switch {
-			case row == 0:
+			case row == table.HeaderRow:
 				return lipgloss.NewStyle().Foreground(lipgloss.Color("#7D56F4")).Bold(true)
+			case row == m.selectedMatch:
+				// Highlight selected row, whatever its played state
+				return lipgloss.NewStyle().
+					Background(lipgloss.Color("#7D56F4")).
+					Foreground(lipgloss.Color("#FFFFFF"))
+			case row < len(matches) && matches[row].Played:
+				return lipgloss.NewStyle().Foreground(lipgloss.Color("#50C878"))
 			default:
-				return lipgloss.NewStyle().Foreground(lipgloss.Color("252"))
+				return lipgloss.NewStyle().Foreground(lipgloss.Color("#FF6B6B"))
 			}
 		}).
-		Headers("DUELO", "PLAYED", "HOME", "AWAY", "RESULT", "DATE", "TOURNAMENT_ID")
+		Headers(headers...)
+
+	widths := matchColumnWidths{
+		player:       m.calculateMaxPlayerNameWidth(),
+		date:         m.calculateMaxDateWidth(),
+		tournamentID: m.calculateMaxTournamentIDWidth(),
+	}
 
-	for i, match := range matches {
-		var playedStatus string
+	for _, match := range matches {
+		t.Row(m.matchRowCells(match, layout, widths)...)
+	}
 
-		var result string
+	return t.Render()
+}
 
-		var datetime string
+// matchColumnWidths are the fixed widths of the padded matches table columns
+type matchColumnWidths struct {
+	player       int
+	date         int
+	tournamentID int
+}
 
-		var tournamentID string
+// matchRowCells formats the cells of one match for the given table layout
+func (m *FixtureModel) matchRowCells(
+	match *fixtures.Match,
+	layout matchesTableLayout,
+	widths matchColumnWidths,
+) []string {
+	// Format played status
+	playedStatus := m.markers.For(match)
+
+	// Format result
+	result := "-"
+	if match.Played {
+		result = fixtures.FormatScore(match.HomeScore) + "-" + fixtures.FormatScore(match.AwayScore)
+	}
 
-		// Format played status
-		if match.Played {
-			playedStatus = "✓"
-		} else {
-			playedStatus = "○"
-		}
+	// Pad player names to consistent width, a blank bye opponent showing as BYE
+	homePlayer, awayPlayer := byeName(match, match.HomePlayer), byeName(match, match.AwayPlayer)
+	if layout.padNames {
+		homePlayer = fmt.Sprintf("%-*s", widths.player, homePlayer)
+		awayPlayer = fmt.Sprintf("%-*s", widths.player, awayPlayer)
+	}
 
-		// Format result
-		if match.Played {
-			result = fmt.Sprintf("%d-%d", match.HomeScore, match.AwayScore)
-		} else {
-			result = "-"
-		}
+	// Format match number (Duelo)
+	cells := []string{fmt.Sprintf("%d", match.ID), playedStatus, homePlayer, awayPlayer, result}
 
-		// Format datetime with fixed width
+	// Format datetime with fixed width
+	if layout.showDate {
+		datetime := "-"
 		if match.DateTime != "" {
-			datetime = fmt.Sprintf("%-*s", maxDateWidth, match.DateTime)
-		} else {
-			datetime = fmt.Sprintf("%-*s", maxDateWidth, "-")
+			datetime = m.displayDateTime(match)
 		}
+		cells = append(cells, fmt.Sprintf("%-*s", widths.date, datetime))
+	}
 
-		// Extract tournament ID with fixed width
-		tournamentID = m.extractTournamentID(match.BGALink)
-		if tournamentID == "" {
-			tournamentID = fmt.Sprintf("%-*s", maxTournamentIDWidth, "-")
-		} else {
-			tournamentID = fmt.Sprintf("%-*s", maxTournamentIDWidth, tournamentID)
-		}
+	// Format schedule state so agreed matches without a tournament stand out
+	cells = append(cells, fmt.Sprintf("%-*s", scheduleStateWidth, formatScheduleState(match)))
 
-		// Pad player names to consistent width
-		homePlayer := fmt.Sprintf("%-*s", maxPlayerWidth, match.HomePlayer)
-		awayPlayer := fmt.Sprintf("%-*s", maxPlayerWidth, match.AwayPlayer)
+	// Extract tournament ID with fixed width
+	if layout.showTournamentID {
+		cells = append(cells, fmt.Sprintf("%-*s", widths.tournamentID, tournamentIDLabel(match.BGALink)))
+	}
 
-		// Format match number (Duelo)
-		matchNumber := fmt.Sprintf("%d", match.ID)
+	return cells
+}
 
-		// Add selection indicator for the selected match
-		rowData := []string{matchNumber, playedStatus, homePlayer, awayPlayer, result, datetime, tournamentID}
-		if i == m.selectedMatch {
-			// Highlight selected row
-			for j, cell := range rowData {
-				rowData[j] = lipgloss.NewStyle().
-					Background(lipgloss.Color("#7D56F4")).
-					Foreground(lipgloss.Color("#FFFFFF")).
-					Render(cell)
-			}
-		}
+// scheduleStateWidth fits the longest schedule state label
+const scheduleStateWidth = len("needs tournament")
 
-		t.Row(rowData[0], rowData[1], rowData[2], rowData[3], rowData[4], rowData[5], rowData[6])
+// formatScheduleState renders the schedule state of a match, leaving played matches blank
+func formatScheduleState(match *fixtures.Match) string {
+	if match.IsBye() {
+		return "BYE"
+	}
+	if match.Played {
+		return "-"
 	}
 
-	return t.Render()
+	return match.ScheduleState().String()
+}
+
+// byeName shows the blank opponent of a bye as the bye placeholder
+func byeName(match *fixtures.Match, player string) string {
+	if match.IsBye() && strings.TrimSpace(player) == "" {
+		return fixtures.DefaultByeSentinel
+	}
+
+	return player
 }
 
 // calculateMaxPlayerNameWidth finds the longest player name across all rounds
@@ -500,8 +1006,8 @@ func (m *FixtureModel) calculateMaxDateWidth() int {
 
 	for _, round := range m.division.Rounds {
 		for _, match := range round.Matches {
-			if match.DateTime != "" && len(match.DateTime) > maxWidth {
-				maxWidth = len(match.DateTime)
+			if dateTime := m.displayDateTime(match); len(dateTime) > maxWidth {
+				maxWidth = len(dateTime)
 			}
 		}
 	}
@@ -515,11 +1021,8 @@ func (m *FixtureModel) calculateMaxTournamentIDWidth() int {
 
 	for _, round := range m.division.Rounds {
 		for _, match := range round.Matches {
-			if match.BGALink != "" {
-				tournamentID := m.extractTournamentID(match.BGALink)
-				if len(tournamentID) > maxWidth {
-					maxWidth = len(tournamentID)
-				}
+			if tournamentID := tournamentIDLabel(match.BGALink); len(tournamentID) > maxWidth {
+				maxWidth = len(tournamentID)
 			}
 		}
 	}
@@ -527,21 +1030,14 @@ func (m *FixtureModel) calculateMaxTournamentIDWidth() int {
 	return maxWidth
 }
 
-// extractTournamentID extracts the tournament ID from a BGA URL
-func (m *FixtureModel) extractTournamentID(bgaURL string) string {
-	if bgaURL == "" {
-		return ""
-	}
-
-	parsedURL, err := url.Parse(bgaURL)
+// tournamentIDLabel shows the tournament ID of a BGA link, or "-" when the link holds none
+func tournamentIDLabel(link string) string {
+	id, err := bga.ExtractTournamentID(link)
 	if err != nil {
-		return ""
+		return "-"
 	}
 
-	// Extract the 'id' parameter from the query string
-	tournamentID := parsedURL.Query().Get("id")
-
-	return tournamentID
+	return strconv.Itoa(id)
 }
 
 // GetCurrentRound returns the currently displayed round
@@ -553,8 +1049,33 @@ func (m *FixtureModel) GetCurrentRound() *fixtures.Round {
 	return nil
 }
 
+// handleDateTimeSelected shows the confirmation screen for the picked datetime
+func (m *FixtureModel) handleDateTimeSelected(msg DateTimeSelectedMsg) (tea.Model, tea.Cmd) {
+	m.showDatePicker = false
+	m.confirmationModel = NewTournamentConfirmationModel(
+		msg.HomePlayer,
+		msg.AwayPlayer,
+		msg.Division,
+		msg.RoundNumber,
+		msg.MatchNumber,
+		msg.MatchID,
+		msg.DateTime,
+	)
+	m.confirmationModel.SetUse24Hour(m.use24Hour)
+	m.confirmationModel.SetSeason(m.division.Season)
+	m.confirmationModel.SetHeadToHead(m.division)
+	m.showConfirmation = true
+
+	return m, nil
+}
+
 // handleSubModelMessages handles messages for date picker and confirmation models
 func (m *FixtureModel) handleSubModelMessages(msg tea.Msg) (tea.Model, tea.Cmd, bool) {
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && m.errorModel != nil && keyMsg.Type != tea.KeyCtrlC {
+		_, cmd := m.errorModel.Update(keyMsg)
+		return m, cmd, true
+	}
+
 	if m.showDatePicker && m.dateTimePicker != nil {
 		switch msg.(type) {
 		case DateTimeSelectedMsg, DateTimePickerCanceledMsg:
@@ -589,6 +1110,10 @@ func (m *FixtureModel) handleSubModelMessages(msg tea.Msg) (tea.Model, tea.Cmd,
 
 // handleKeyMessages handles all keyboard input
 func (m *FixtureModel) handleKeyMessages(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if model, cmd, handled := m.handlePromptKeys(msg); handled {
+		return model, cmd
+	}
+
 	switch msg.Type {
 	case tea.KeyCtrlC:
 		return m, tea.Quit
@@ -597,6 +1122,14 @@ func (m *FixtureModel) handleKeyMessages(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	case tea.KeyRight, tea.KeyPgDown:
 		return m.handleRoundNavigation(1), nil
 	case tea.KeyEsc:
+		if m.cancelCreate != nil {
+			m.cancelCreate()
+			if m.bulk != nil {
+				m.bulk.queue = nil
+			}
+			m.statusMessage = "Canceling tournament creation..."
+			return m, nil
+		}
 		return m, func() tea.Msg { return BackToMenuMsg{} }
 	case tea.KeyDown:
 		m.handleMatchSelection(1)
@@ -610,6 +1143,42 @@ func (m *FixtureModel) handleKeyMessages(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch msg.String() {
 	case "c":
 		return m.handleCreateTournament()
+	case "a":
+		return m.handleCreateScheduled()
+	case "b":
+		return m.handleBatchRound()
+	case "R":
+		return m.handleRetryFailed()
+	case "S":
+		return m.handleRescheduleKey()
+	case "T":
+		return m.toggleTimeFormat()
+	case "u":
+		return m.handleJumpToSoonest()
+	case "n":
+		return m.handleJumpToNextRound()
+	case "D":
+		return m.toggleISODates()
+	case "w":
+		return m.handleWalkoverKey()
+	case "e":
+		return m.handleResultKey()
+	case "Y":
+		return m.handleRecopyLastLink()
+	case "y":
+		return m.handleCopyTournamentID()
+	case "o":
+		return m.handleOpenLink()
+	case "r":
+		return m.handleRefreshStatus()
+	case "s":
+		return m.handleShareResult()
+	case "i":
+		return m.handleExportCalendar()
+	case "/":
+		return m.handleSearchKey()
+	case "?":
+		m.helpCollapsed = !m.helpCollapsed
 	case "h":
 		return m.handleRoundNavigation(-1), nil
 	case "l":
@@ -625,6 +1194,85 @@ func (m *FixtureModel) handleKeyMessages(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// withSpinner runs a tournament creation command alongside the spinner shown until it finishes
+func (m *FixtureModel) withSpinner(cmd tea.Cmd) tea.Cmd {
+	if cmd == nil {
+		return nil
+	}
+	if m.spinnerActive {
+		return cmd
+	}
+
+	m.spinnerActive = true
+	return tea.Batch(cmd, m.spinner.Tick)
+}
+
+// updateSpinner advances the spinner, letting its ticks stop once no creation is in flight
+func (m *FixtureModel) updateSpinner(msg spinner.TickMsg) tea.Cmd {
+	if !m.spinnerActive {
+		return nil
+	}
+
+	var cmd tea.Cmd
+	m.spinner, cmd = m.spinner.Update(msg)
+	return cmd
+}
+
+// renderStatus renders the status message, after the spinner while a creation is in flight
+func (m *FixtureModel) renderStatus() string {
+	status := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#50C878")).
+		Bold(true).
+		Render(m.statusMessage)
+
+	if m.spinnerActive {
+		return m.spinner.View() + " " + status
+	}
+
+	return status
+}
+
+// handlePromptKeys hands every key but ctrl+c to the prompt or input on screen, if any
+func (m *FixtureModel) handlePromptKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd, bool) {
+	if msg.Type == tea.KeyCtrlC {
+		return m, nil, false
+	}
+
+	switch {
+	case m.walkoverPrompt:
+		model, cmd := m.handleWalkoverChoice(msg)
+		return model, cmd, true
+	case m.resultPrompt:
+		model, cmd := m.handleResultChoice(msg)
+		return model, cmd, true
+	case m.searching:
+		model, cmd := m.updateSearch(msg)
+		return model, cmd, true
+	case m.rescheduling:
+		model, cmd := m.updateReschedule(msg)
+		return model, cmd, true
+	case m.batchPending != nil:
+		model, cmd := m.handleBatchChoice(msg)
+		return model, cmd, true
+	}
+
+	return m, nil, false
+}
+
+// capturingInput reports whether a form or prompt on the fixture screen takes every key
+func (m *FixtureModel) capturingInput() bool {
+	return m.typingText() || m.showDatePicker || m.showConfirmation || m.errorModel != nil ||
+		m.walkoverPrompt || m.resultPrompt || m.batchPending != nil
+}
+
+// typingText reports whether keys go into a text field: the search, the reschedule offset or a player name
+func (m *FixtureModel) typingText() bool {
+	editingName := m.showConfirmation && m.confirmationModel != nil &&
+		m.confirmationModel.editingPlayer != noPlayerField
+
+	return m.searching || m.rescheduling || editingName
+}
+
 // handleRoundNavigation navigates between rounds
 func (m *FixtureModel) handleRoundNavigation(direction int) *FixtureModel {
 	m.currentRound += direction
@@ -652,6 +1300,73 @@ func (m *FixtureModel) handleMatchSelection(direction int) {
 	}
 }
 
+// handleJumpToNextRound handles 'n' key to show the earliest round with matches still needing tournaments
+func (m *FixtureModel) handleJumpToNextRound() (tea.Model, tea.Cmd) {
+	if len(m.division.Rounds) == 0 {
+		return m, nil
+	}
+
+	m.currentRound, m.selectedMatch = m.division.NextRoundToSchedule(now()), 0
+
+	round := m.division.Rounds[m.currentRound]
+	if round.NeedsTournaments() {
+		m.statusMessage = fmt.Sprintf("Next round to schedule: Round %d", round.Number)
+	} else {
+		m.statusMessage = "Every match is played or has a tournament"
+	}
+
+	return m, m.clearStatus()
+}
+
+// handleJumpToSoonest handles 'u' key to select the most urgent match still needing a tournament
+// That is the earliest scheduled one, or the first unscheduled one when none has a readable date
+func (m *FixtureModel) handleJumpToSoonest() (tea.Model, tea.Cmd) {
+	var soonest, fallback *fixtures.Match
+	var soonestRound, soonestIndex, fallbackRound, fallbackIndex int
+	var soonestTime time.Time
+	current := now()
+
+	for roundIndex, round := range m.division.Rounds {
+		for matchIndex, match := range round.Matches {
+			if match.Played || match.IsBye() || match.BGALink != "" {
+				continue
+			}
+
+			if fallback == nil {
+				fallback, fallbackRound, fallbackIndex = match, roundIndex, matchIndex
+			}
+
+			if !match.HasAgreedDateTime() {
+				continue
+			}
+
+			dateTime, err := match.ScheduledTime(current)
+			if err != nil {
+				continue
+			}
+
+			if soonest == nil || dateTime.Before(soonestTime) {
+				soonest, soonestRound, soonestIndex, soonestTime = match, roundIndex, matchIndex, dateTime
+			}
+		}
+	}
+
+	switch {
+	case soonest != nil:
+		m.currentRound, m.selectedMatch = soonestRound, soonestIndex
+		m.statusMessage = fmt.Sprintf("Soonest match: %s vs %s on %s",
+			soonest.HomePlayer, soonest.AwayPlayer, soonest.DateTime)
+	case fallback != nil:
+		m.currentRound, m.selectedMatch = fallbackRound, fallbackIndex
+		m.statusMessage = fmt.Sprintf("No scheduled matches, first one needing a tournament: %s vs %s",
+			fallback.HomePlayer, fallback.AwayPlayer)
+	default:
+		m.statusMessage = "No matches need a tournament"
+	}
+
+	return m, m.clearStatus()
+}
+
 // handleMatchEnter handles Enter key on selected match
 func (m *FixtureModel) handleMatchEnter() (tea.Model, tea.Cmd) {
 	currentRound := m.GetCurrentRound()
@@ -662,22 +1377,61 @@ func (m *FixtureModel) handleMatchEnter() (tea.Model, tea.Cmd) {
 	selectedMatch := currentRound.Matches[m.selectedMatch]
 	if selectedMatch.Played && selectedMatch.BGALink != "" {
 		// Copy existing link to clipboard
-		if err := clipboard.WriteAll(selectedMatch.BGALink); err == nil {
+		if err := clipboardWriteAll(selectedMatch.BGALink); err == nil {
 			m.statusMessage = "Tournament link copied to clipboard!"
+			m.lastLink = selectedMatch.BGALink
 		} else {
 			m.statusMessage = "Failed to copy link to clipboard"
 		}
 
-		return m, tea.Tick(time.Second*3, func(time.Time) tea.Msg {
-			return clearStatusMsg{}
-		})
+		return m, m.clearStatus()
 	}
 
 	// Match not played, show create tournament message
 	m.statusMessage = "Press 'c' to create tournament for this match"
+	if selectedMatch.IsBye() {
+		m.statusMessage = "This match is a bye, no tournament is needed"
+	}
 	return m, nil
 }
 
+// handleRecopyLastLink handles 'Y' key to copy the last created or copied link again, whatever is selected
+func (m *FixtureModel) handleRecopyLastLink() (tea.Model, tea.Cmd) {
+	switch {
+	case m.lastLink == "":
+		m.statusMessage = "No link created or copied yet"
+	case clipboardWriteAll(m.lastLink) != nil:
+		m.statusMessage = "Failed to copy link to clipboard"
+	default:
+		m.statusMessage = "Re-copied last link"
+	}
+
+	return m, m.clearStatus()
+}
+
+// handleOpenLink handles 'o' key to open the selected match's tournament in the browser
+func (m *FixtureModel) handleOpenLink() (tea.Model, tea.Cmd) {
+	match := m.selectedFixtureMatch()
+	switch {
+	case match == nil || match.BGALink == "":
+		m.statusMessage = "No tournament link for this match"
+	case browserOpen(match.BGALink) != nil:
+		m.statusMessage = "Failed to open the link in the browser"
+	default:
+		m.statusMessage = "Opened tournament in the browser"
+	}
+
+	return m, m.clearStatus()
+}
+
+// missingPlayerStatus is shown instead of creating a tournament named after a blank player
+const missingPlayerStatus = "Match has a missing player name"
+
+// missingPlayerName reports whether a tournament would be created without one of its player names
+func missingPlayerName(homePlayer, awayPlayer string) bool {
+	return strings.TrimSpace(homePlayer) == "" || strings.TrimSpace(awayPlayer) == ""
+}
+
 // handleCreateTournament handles 'c' key for tournament creation
 func (m *FixtureModel) handleCreateTournament() (tea.Model, tea.Cmd) {
 	currentRound := m.GetCurrentRound()
@@ -686,7 +1440,19 @@ func (m *FixtureModel) handleCreateTournament() (tea.Model, tea.Cmd) {
 	}
 
 	selectedMatch := currentRound.Matches[m.selectedMatch]
+	switch {
+	case selectedMatch.IsBye():
+		m.statusMessage = "No tournament is needed for a bye"
+		return m, m.clearStatus()
+	case missingPlayerName(selectedMatch.HomePlayer, selectedMatch.AwayPlayer):
+		m.statusMessage = missingPlayerStatus
+		return m, m.clearStatus()
+	}
+
 	if !selectedMatch.Played {
+		// Open the picker on the round's first day, or today when its range can't be read
+		defaultDate, _ := currentRound.NearestStartDate(now())
+
 		// Create and show datetime picker
 		m.dateTimePicker = NewDateTimePickerModel(
 			selectedMatch.HomePlayer,
@@ -695,7 +1461,9 @@ func (m *FixtureModel) handleCreateTournament() (tea.Model, tea.Cmd) {
 			m.currentRound+1,
 			selectedMatch.ID, // Use match ID as match number
 			selectedMatch.ID,
+			defaultDate,
 		)
+		m.dateTimePicker.SetUse24Hour(m.use24Hour)
 		m.showDatePicker = true
 		return m, m.dateTimePicker.Init()
 	}
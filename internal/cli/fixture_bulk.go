@@ -0,0 +1,228 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// batchStartHour is the time of day given to batch tournaments of matches without an agreed datetime
+const batchStartHour = 21
+
+// bulkCreation tracks a queue of tournaments being created one after another
+type bulkCreation struct {
+	queue   []createTournamentMsgWithDateTime
+	failed  []createTournamentMsgWithDateTime
+	current createTournamentMsgWithDateTime
+	total   int
+	created int
+	skipped int
+}
+
+// handleCreateScheduled queues tournament creation for every scheduled match without a tournament
+func (m *FixtureModel) handleCreateScheduled() (tea.Model, tea.Cmd) {
+	if m.bulk != nil || m.cancelCreate != nil {
+		m.statusMessage = "A tournament creation is already in progress"
+		return m, m.clearStatus()
+	}
+
+	bulk := &bulkCreation{}
+	current := now()
+
+	for roundIndex, round := range m.division.Rounds {
+		for _, match := range round.Matches {
+			if match.Played || match.IsBye() || match.BGALink != "" || !match.HasAgreedDateTime() {
+				continue
+			}
+
+			dateTime, err := match.ScheduledTime(current)
+			if err != nil {
+				bulk.skipped++
+				continue
+			}
+
+			bulk.queue = append(bulk.queue, createTournamentMsgWithDateTime{
+				dateTime:    dateTime,
+				homePlayer:  match.HomePlayer,
+				awayPlayer:  match.AwayPlayer,
+				division:    m.division.Name,
+				matchID:     match.ID,
+				roundNum:    roundIndex,
+				matchNumber: match.ID,
+			})
+		}
+	}
+
+	if len(bulk.queue) == 0 {
+		m.statusMessage = "No scheduled matches need a tournament"
+		if bulk.skipped > 0 {
+			m.statusMessage += fmt.Sprintf(" (%d skipped with unreadable dates)", bulk.skipped)
+		}
+		return m, m.clearStatus()
+	}
+
+	return m, m.startBulk(bulk)
+}
+
+// handleBatchRound handles 'b' key, asking to create tournaments for every unplayed match of the round
+// Matches keep their agreed datetime, the others are set to the round's first day at batchStartHour
+func (m *FixtureModel) handleBatchRound() (tea.Model, tea.Cmd) {
+	if m.bulk != nil || m.cancelCreate != nil {
+		m.statusMessage = "A tournament creation is already in progress"
+		return m, m.clearStatus()
+	}
+
+	round := m.GetCurrentRound()
+	if round == nil {
+		return m, nil
+	}
+
+	current := now()
+	roundStart, hasRoundStart := round.NearestStartDate(current)
+	roundStart = roundStart.Add(batchStartHour * time.Hour)
+	batch := &bulkCreation{}
+
+	for _, match := range round.Matches {
+		if match.Played || match.IsBye() || match.BGALink != "" {
+			continue
+		}
+
+		dateTime, err := match.ScheduledTime(current)
+		if err != nil || !match.HasAgreedDateTime() {
+			if !hasRoundStart {
+				batch.skipped++
+				continue
+			}
+			dateTime = roundStart
+		}
+
+		batch.queue = append(batch.queue, createTournamentMsgWithDateTime{
+			dateTime:    dateTime,
+			homePlayer:  match.HomePlayer,
+			awayPlayer:  match.AwayPlayer,
+			division:    m.division.Name,
+			matchID:     match.ID,
+			roundNum:    m.currentRound,
+			matchNumber: match.ID,
+		})
+	}
+
+	if len(batch.queue) == 0 {
+		m.statusMessage = "No unplayed matches in this round need a tournament"
+		if batch.skipped > 0 {
+			m.statusMessage += fmt.Sprintf(" (%d skipped without a readable date)", batch.skipped)
+		}
+		return m, m.clearStatus()
+	}
+
+	m.batchPending = batch
+	return m, nil
+}
+
+// handleBatchChoice starts the pending round batch on y or Enter, any other key cancels it
+func (m *FixtureModel) handleBatchChoice(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	batch := m.batchPending
+	m.batchPending = nil
+
+	if msg.String() != "y" && msg.Type != tea.KeyEnter {
+		m.statusMessage = "Batch creation canceled"
+		return m, m.clearStatus()
+	}
+
+	return m, m.startBulk(batch)
+}
+
+// renderBatchConfirmation lists the tournaments a round batch is about to create
+func (m *FixtureModel) renderBatchConfirmation() string {
+	round := m.GetCurrentRound()
+	title := m.style.Render(fmt.Sprintf("Division %s - Round %d - Create all tournaments", m.division.Name, round.Number))
+	s := fmt.Sprintf("\n%s\n\n", title)
+
+	for _, pending := range m.batchPending.queue {
+		s += fmt.Sprintf("Duelo %d: %s vs %s - %s\n", pending.matchID, pending.homePlayer, pending.awayPlayer,
+			pending.dateTime.Format(displayTimeLayout(m.use24Hour)))
+	}
+
+	if m.batchPending.skipped > 0 {
+		s += lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#FF6B6B")).
+			Render(fmt.Sprintf("%d skipped without a readable date", m.batchPending.skipped))
+		s += "\n"
+	}
+
+	s += "\n" + wrapToWidth(fmt.Sprintf("Press y or enter to create these %d tournaments, any other key cancels.",
+		len(m.batchPending.queue)), m.width)
+	s += "\n"
+
+	return s
+}
+
+// handleRetryFailed re-attempts only the creations that failed in the last bulk run
+func (m *FixtureModel) handleRetryFailed() (tea.Model, tea.Cmd) {
+	if len(m.failedBulk) == 0 {
+		return m, nil
+	}
+
+	if m.bulk != nil || m.cancelCreate != nil {
+		m.statusMessage = "A tournament creation is already in progress"
+		return m, m.clearStatus()
+	}
+
+	bulk := &bulkCreation{queue: m.failedBulk}
+	m.failedBulk = nil
+
+	return m, m.startBulk(bulk)
+}
+
+// startBulk begins working through the queue of a bulk run
+func (m *FixtureModel) startBulk(bulk *bulkCreation) tea.Cmd {
+	bulk.total = len(bulk.queue)
+	m.bulk = bulk
+
+	return m.afterCreation()
+}
+
+// afterCreation starts the next queued bulk creation, or finishes the current one
+func (m *FixtureModel) afterCreation() tea.Cmd {
+	if m.bulk == nil {
+		return m.clearStatus()
+	}
+
+	if len(m.bulk.queue) == 0 {
+		m.statusMessage = fmt.Sprintf("Scheduled matches done: %d created, %d failed", m.bulk.created, len(m.bulk.failed))
+		if m.bulk.skipped > 0 {
+			m.statusMessage += fmt.Sprintf(", %d skipped with unreadable dates", m.bulk.skipped)
+		}
+		if len(m.bulk.failed) > 0 {
+			m.statusMessage += " - press 'R' to retry failed"
+		}
+		m.failedBulk = m.bulk.failed
+		m.bulk = nil
+		return m.clearStatus()
+	}
+
+	next := m.bulk.queue[0]
+	m.bulk.queue = m.bulk.queue[1:]
+	m.bulk.current = next
+	m.statusMessage = fmt.Sprintf("[%d/%d] Creating tournament for %s vs %s...",
+		m.bulk.total-len(m.bulk.queue), m.bulk.total, next.homePlayer, next.awayPlayer)
+
+	return func() tea.Msg {
+		return next
+	}
+}
+
+// recordBulkResult counts a finished creation when a bulk run is in progress
+func (m *FixtureModel) recordBulkResult(created bool) {
+	if m.bulk == nil {
+		return
+	}
+
+	if created {
+		m.bulk.created++
+	} else {
+		m.bulk.failed = append(m.bulk.failed, m.bulk.current)
+	}
+}
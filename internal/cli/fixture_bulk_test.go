@@ -0,0 +1,324 @@
+package cli
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"carca-cli/internal/bga"
+	"carca-cli/internal/fixtures"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// runBulkCreation executes commands until the bulk run in progress has finished
+func runBulkCreation(t *testing.T, model *FixtureModel, cmd tea.Cmd) {
+	t.Helper()
+
+	for steps := 0; model.bulk != nil; steps++ {
+		if cmd == nil {
+			t.Fatal("Expected a command while bulk creation is in progress")
+		}
+		if steps > 100 {
+			t.Fatal("Bulk creation did not finish")
+		}
+
+		_, cmd = model.Update(runCmd(cmd))
+	}
+}
+
+func TestFixtureModel_CreateScheduled_OnlyScheduledMatches(t *testing.T) {
+	division := &fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, Matches: []*fixtures.Match{
+				{ID: 1, HomePlayer: "herchu", AwayPlayer: "webbi", DateTime: "01/09 - 21:15"},
+				{ID: 2, HomePlayer: "Lord Trooper", AwayPlayer: "alehrosario", DateTime: "-"},
+				{ID: 3, HomePlayer: "Academia47", AwayPlayer: "bignacho610", DateTime: "02/09 - 20:15", Played: true},
+			}},
+			{Number: 2, Matches: []*fixtures.Match{
+				{ID: 4, HomePlayer: "webbi", AwayPlayer: "Lord Trooper", DateTime: "08/09 - 22:00"},
+				{
+					ID: 5, HomePlayer: "alehrosario", AwayPlayer: "herchu", DateTime: "09/09 - 19:00",
+					BGALink: "https://boardgamearena.com/tournament?id=400000",
+				},
+				{ID: 6, HomePlayer: "bignacho610", AwayPlayer: "Academia47"},
+			}},
+		},
+	}
+	model := NewFixtureModel(division)
+
+	mockClient := bga.NewMockClient("testuser", "testpass")
+	if err := mockClient.Login(context.Background()); err != nil {
+		t.Fatalf("Failed to login mock client: %v", err)
+	}
+	model.SetBGAClient(mockClient)
+
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
+	if model.bulk == nil || model.bulk.total != 2 {
+		t.Fatalf("Expected 2 scheduled matches queued, got %+v", model.bulk)
+	}
+
+	runBulkCreation(t, model, cmd)
+
+	if !strings.Contains(model.statusMessage, "2 created, 0 failed") {
+		t.Errorf("Expected summary status, got %q", model.statusMessage)
+	}
+
+	tournaments := mockClient.ListTournamentsSorted()
+	if len(tournaments) != 2 {
+		t.Fatalf("Expected 2 tournaments, got %d", len(tournaments))
+	}
+
+	for i, name := range []string{"1 Fecha - Duelo 1 - herchu vs webbi", "2 Fecha - Duelo 4 - webbi vs Lord Trooper"} {
+		if tournaments[i].Name != name {
+			t.Errorf("Expected tournament %d to be %q, got %q", i, name, tournaments[i].Name)
+		}
+	}
+
+	for _, match := range []*fixtures.Match{division.Rounds[0].Matches[0], division.Rounds[1].Matches[0]} {
+		if match.BGALink == "" {
+			t.Errorf("Expected match %d to get a tournament link", match.ID)
+		}
+	}
+	for _, match := range []*fixtures.Match{division.Rounds[0].Matches[1], division.Rounds[1].Matches[2]} {
+		if match.BGALink != "" {
+			t.Errorf("Expected unscheduled match %d to be skipped", match.ID)
+		}
+	}
+	if division.Rounds[1].Matches[1].BGALink != "https://boardgamearena.com/tournament?id=400000" {
+		t.Error("Expected existing tournament link to be left untouched")
+	}
+}
+
+func TestFixtureModel_CreateScheduled_UsesMatchDateTime(t *testing.T) {
+	division := &fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, Matches: []*fixtures.Match{
+				{ID: 7, HomePlayer: "herchu", AwayPlayer: "webbi", DateTime: "03/09 - 08:00"},
+			}},
+		},
+	}
+	model := NewFixtureModel(division)
+
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
+	if cmd == nil {
+		t.Fatal("Expected a command to start the bulk creation")
+	}
+
+	job, ok := cmd().(createTournamentMsgWithDateTime)
+	if !ok {
+		t.Fatal("Expected createTournamentMsgWithDateTime for the scheduled match")
+	}
+
+	if job.dateTime.Day() != 3 || job.dateTime.Month() != time.September || job.dateTime.Hour() != 8 {
+		t.Errorf("Expected 03/09 08:00, got %v", job.dateTime)
+	}
+	if job.matchNumber != 7 || job.roundNum != 0 {
+		t.Errorf("Expected match 7 in round index 0, got match %d round %d", job.matchNumber, job.roundNum)
+	}
+}
+
+func TestFixtureModel_CreateScheduled_NothingToCreate(t *testing.T) {
+	division := &fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, Matches: []*fixtures.Match{
+				{ID: 1, HomePlayer: "herchu", AwayPlayer: "webbi", DateTime: "-"},
+			}},
+		},
+	}
+	model := NewFixtureModel(division)
+
+	_, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
+	if model.bulk != nil {
+		t.Error("Expected no bulk creation to start")
+	}
+	if !strings.Contains(model.statusMessage, "No scheduled matches") {
+		t.Errorf("Expected nothing-to-do status, got %q", model.statusMessage)
+	}
+}
+
+func TestFixtureModel_RetryFailedBulkCreations(t *testing.T) {
+	division := &fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, Matches: []*fixtures.Match{
+				{ID: 1, HomePlayer: "herchu", AwayPlayer: "webbi", DateTime: "01/09 - 21:15"},
+				{ID: 2, HomePlayer: "Lord Trooper", AwayPlayer: "alehrosario", DateTime: "01/09 - 22:00"},
+				{ID: 3, HomePlayer: "Academia47", AwayPlayer: "bignacho610", DateTime: "02/09 - 20:15"},
+			}},
+		},
+	}
+	model := NewFixtureModel(division)
+
+	mockClient := bga.NewMockClient("testuser", "testpass")
+	if err := mockClient.Login(context.Background()); err != nil {
+		t.Fatalf("Failed to login mock client: %v", err)
+	}
+	mockClient.FailNextCreates(2)
+	model.SetBGAClient(mockClient)
+
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
+	runBulkCreation(t, model, cmd)
+
+	if !strings.Contains(model.statusMessage, "1 created, 2 failed") {
+		t.Errorf("Expected summary with failures, got %q", model.statusMessage)
+	}
+	if len(model.failedBulk) != 2 {
+		t.Fatalf("Expected 2 failed creations tracked, got %d", len(model.failedBulk))
+	}
+	if !strings.Contains(model.View(), "retry failed (2)") {
+		t.Error("Expected view to offer retrying the failed creations")
+	}
+
+	_, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'R'}})
+	if model.bulk == nil || model.bulk.total != 2 {
+		t.Fatalf("Expected retry to queue only the 2 failed creations, got %+v", model.bulk)
+	}
+	runBulkCreation(t, model, cmd)
+
+	if !strings.Contains(model.statusMessage, "2 created, 0 failed") {
+		t.Errorf("Expected retry summary, got %q", model.statusMessage)
+	}
+	if len(model.failedBulk) != 0 {
+		t.Errorf("Expected no failures left, got %d", len(model.failedBulk))
+	}
+	if len(mockClient.GetTournaments()) != 3 {
+		t.Errorf("Expected 3 tournaments in total, got %d", len(mockClient.GetTournaments()))
+	}
+	for _, match := range division.Rounds[0].Matches {
+		if match.BGALink == "" {
+			t.Errorf("Expected match %d to have a tournament link after retry", match.ID)
+		}
+	}
+}
+
+func TestFixtureModel_RetryFailed_NothingToRetry(t *testing.T) {
+	model := NewFixtureModel(&fixtures.Division{Name: "Elite"})
+
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'R'}})
+	if cmd != nil || model.bulk != nil {
+		t.Error("Expected retry to do nothing without failed creations")
+	}
+}
+
+func TestFixtureModel_BatchRound_CreatesEveryUnplayedMatch(t *testing.T) {
+	division := &fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, DateRange: "01/09 - 07/09", Matches: []*fixtures.Match{
+				{ID: 1, HomePlayer: "herchu", AwayPlayer: "webbi", Played: true},
+			}},
+			{Number: 2, DateRange: "08/09 - 14/09", Matches: []*fixtures.Match{
+				{ID: 2, HomePlayer: "webbi", AwayPlayer: "Lord Trooper", DateTime: "10/09 - 22:00"},
+				{ID: 3, HomePlayer: "alehrosario", AwayPlayer: "herchu", Played: true},
+				{ID: 4, HomePlayer: "bignacho610", AwayPlayer: "Academia47"},
+				{ID: 5, HomePlayer: "Academia47", AwayPlayer: "webbi", DateTime: "-"},
+			}},
+		},
+	}
+	model := NewFixtureModel(division)
+	model.currentRound = 1
+
+	mockClient := bga.NewMockClient("testuser", "testpass")
+	if err := mockClient.Login(context.Background()); err != nil {
+		t.Fatalf("Failed to login mock client: %v", err)
+	}
+	model.SetBGAClient(mockClient)
+
+	_, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'b'}})
+	if model.batchPending == nil || len(model.batchPending.queue) != 3 {
+		t.Fatalf("Expected 3 unplayed matches awaiting confirmation, got %+v", model.batchPending)
+	}
+
+	view := model.View()
+	for _, line := range []string{"Duelo 2: webbi vs Lord Trooper", "Duelo 4: bignacho610 vs Academia47", "Duelo 5"} {
+		if !strings.Contains(view, line) {
+			t.Errorf("Expected the confirmation to list %q, got:\n%s", line, view)
+		}
+	}
+
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	if !strings.Contains(model.statusMessage, "[1/3]") {
+		t.Errorf("Expected progress in the status, got %q", model.statusMessage)
+	}
+
+	runBulkCreation(t, model, cmd)
+
+	for _, match := range division.Rounds[1].Matches {
+		if !match.Played && match.BGALink == "" {
+			t.Errorf("Expected Duelo %d to get a tournament link", match.ID)
+		}
+	}
+	if division.Rounds[0].Matches[0].BGALink != "" {
+		t.Error("Expected other rounds to be left alone")
+	}
+
+	tournaments := mockClient.ListTournamentsSorted()
+	if len(tournaments) != 3 {
+		t.Fatalf("Expected 3 tournaments, got %d", len(tournaments))
+	}
+}
+
+func TestFixtureModel_BatchRound_UsesRoundStartWithoutAgreedDate(t *testing.T) {
+	freezeClock(t, time.Date(2025, 9, 1, 12, 0, 0, 0, time.Local))
+
+	division := &fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, DateRange: "08/09 - 14/09", Matches: []*fixtures.Match{
+				{ID: 1, HomePlayer: "webbi", AwayPlayer: "Lord Trooper", DateTime: "10/09 - 22:00"},
+				{ID: 2, HomePlayer: "bignacho610", AwayPlayer: "Academia47"},
+			}},
+		},
+	}
+	model := NewFixtureModel(division)
+
+	_, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'b'}})
+	if model.batchPending == nil {
+		t.Fatal("Expected a batch awaiting confirmation")
+	}
+
+	want := []time.Time{
+		time.Date(2025, 9, 10, 22, 0, 0, 0, time.Local),
+		time.Date(2025, 9, 8, batchStartHour, 0, 0, 0, time.Local),
+	}
+	for i, pending := range model.batchPending.queue {
+		if !pending.dateTime.Equal(want[i]) {
+			t.Errorf("Expected Duelo %d at %v, got %v", pending.matchID, want[i], pending.dateTime)
+		}
+	}
+
+	_, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	if model.batchPending != nil || model.bulk != nil || model.statusMessage != "Batch creation canceled" {
+		t.Errorf("Expected any other key to cancel, got status %q", model.statusMessage)
+	}
+}
+
+func TestFixtureModel_BatchRound_RoundStartAfterNewYear(t *testing.T) {
+	freezeClock(t, time.Date(2025, 12, 28, 12, 0, 0, 0, time.Local))
+
+	division := &fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, DateRange: "05/01 - 11/01", Matches: []*fixtures.Match{
+				{ID: 1, HomePlayer: "bignacho610", AwayPlayer: "Academia47"},
+			}},
+		},
+	}
+	model := NewFixtureModel(division)
+
+	_, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'b'}})
+	if model.batchPending == nil || len(model.batchPending.queue) != 1 {
+		t.Fatalf("Expected one match awaiting confirmation, got %+v", model.batchPending)
+	}
+
+	// A January round seen in late December starts in the coming year, not eleven months back
+	want := time.Date(2026, 1, 5, batchStartHour, 0, 0, 0, time.Local)
+	if got := model.batchPending.queue[0].dateTime; !got.Equal(want) {
+		t.Errorf("Expected the round start %v, got %v", want, got)
+	}
+}
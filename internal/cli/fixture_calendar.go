@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"carca-cli/internal/fixtures"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// handleExportCalendar handles 'i' key by writing the division's dated matches to "<division>.ics"
+// The calendar is saved next to the fixture file, or in the working directory for a division parsed from memory
+func (m *FixtureModel) handleExportCalendar() (tea.Model, tea.Cmd) {
+	path := filepath.Join(filepath.Dir(m.division.Filename), m.division.Name+".ics")
+
+	if err := writeCalendar(m.division, path); err != nil {
+		m.statusMessage = fmt.Sprintf("Failed to save calendar: %v", err)
+	} else {
+		m.statusMessage = fmt.Sprintf("Calendar saved to %s", path)
+	}
+
+	return m, m.clearStatus()
+}
+
+// writeCalendar exports the division as an iCalendar file at path
+func writeCalendar(division *fixtures.Division, path string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if err := fixtures.ExportICS(division, file); err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"carca-cli/internal/fixtures"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestFixtureModel_ExportCalendarWritesDivisionICS(t *testing.T) {
+	dir := t.TempDir()
+	division := &fixtures.Division{
+		Name:     "Elite",
+		Filename: filepath.Join(dir, "Liga Argentina - 1° Temporada - Elite-Fixture.csv"),
+		Rounds: []*fixtures.Round{
+			{Number: 1, DateRange: "11/08 - 17/08", Matches: []*fixtures.Match{
+				{ID: 1, HomePlayer: "herchu", AwayPlayer: "webbi", DateTime: "12/08 - 21:30"},
+			}},
+		},
+	}
+	model := NewFixtureModel(division)
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'i'}})
+
+	path := filepath.Join(dir, "Elite.ics")
+	if model.statusMessage != "Calendar saved to "+path {
+		t.Errorf("Expected the saved calendar path in the status, got %q", model.statusMessage)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Expected the calendar to be written: %v", err)
+	}
+	if !strings.Contains(string(data), "SUMMARY:Duelo 1: herchu vs webbi") {
+		t.Errorf("Expected the dated match in the calendar, got:\n%s", data)
+	}
+}
+
+func TestFixtureModel_ExportCalendarReportsWriteFailure(t *testing.T) {
+	division := &fixtures.Division{
+		Name:     "Elite",
+		Filename: filepath.Join(t.TempDir(), "missing", "Elite-Fixture.csv"),
+		Rounds:   []*fixtures.Round{{Number: 1}},
+	}
+	model := NewFixtureModel(division)
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'i'}})
+
+	if !strings.HasPrefix(model.statusMessage, "Failed to save calendar:") {
+		t.Errorf("Expected the write failure in the status, got %q", model.statusMessage)
+	}
+}
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"context"
 	"strings"
 	"testing"
 
@@ -36,7 +37,7 @@ func TestFixtureModel_DateTimePickerIntegration(t *testing.T) {
 	// Set up mock BGA client
 	mockClient := bga.NewMockClient("testuser", "testpass")
 	model.SetBGAClient(mockClient)
-	err := mockClient.Login()
+	err := mockClient.Login(context.Background())
 	if err != nil {
 		t.Fatalf("Failed to login mock client: %v", err)
 	}
@@ -207,7 +208,7 @@ func TestFixtureModel_DateTimePickerIntegration(t *testing.T) {
 	}
 
 	// Step 10: Execute async tournament creation
-	asyncMsg := cmd()
+	asyncMsg := runCmd(cmd)
 	tournamentMsg, ok := asyncMsg.(tournamentCreatedMsg)
 	if !ok {
 		t.Fatalf("Expected tournamentCreatedMsg from async creation, got %T", asyncMsg)
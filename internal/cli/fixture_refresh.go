@@ -0,0 +1,71 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"carca-cli/internal/bga"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// statusRefreshTimeout bounds how long a tournament status refresh waits for BGA
+const statusRefreshTimeout = 15 * time.Second
+
+// tournamentStatusMsg carries the refreshed status of a match's tournament
+type tournamentStatusMsg struct {
+	status       *bga.TournamentStatus
+	err          error
+	tournamentID int
+	matchID      int
+}
+
+// handleRefreshStatus handles 'r' key to fetch the live status of the selected match's tournament
+func (m *FixtureModel) handleRefreshStatus() (tea.Model, tea.Cmd) {
+	match := m.selectedFixtureMatch()
+	if match == nil || match.BGALink == "" {
+		m.statusMessage = "No tournament link for this match"
+		return m, m.clearStatus()
+	}
+
+	tournamentID, err := bga.ExtractTournamentID(match.BGALink)
+	if err != nil {
+		m.statusMessage = "Invalid tournament link for this match"
+		return m, m.clearStatus()
+	}
+
+	if m.bgaClient == nil {
+		m.statusMessage = "Not connected to BGA"
+		return m, m.clearStatus()
+	}
+
+	client, matchID := m.bgaClient, match.ID
+	m.statusMessage = fmt.Sprintf("Refreshing tournament %d...", tournamentID)
+
+	return m, func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), statusRefreshTimeout)
+		defer cancel()
+
+		status, err := client.GetTournamentStatus(ctx, tournamentID)
+		return tournamentStatusMsg{status: status, err: err, tournamentID: tournamentID, matchID: matchID}
+	}
+}
+
+// handleTournamentStatus shows a refreshed tournament status with the duel's running score
+func (m *FixtureModel) handleTournamentStatus(msg tournamentStatusMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		reason := friendlyErrorText(msg.err)
+		if reason == "" {
+			reason = msg.err.Error()
+		}
+		m.statusMessage = fmt.Sprintf("Could not refresh tournament %d: %s", msg.tournamentID, reason)
+
+		return m, m.clearStatus()
+	}
+
+	homeWins, awayWins := msg.status.DuelScore()
+	m.statusMessage = fmt.Sprintf("Duelo %d: %s %d-%d", msg.matchID, msg.status.Status, homeWins, awayWins)
+
+	return m, m.clearStatus()
+}
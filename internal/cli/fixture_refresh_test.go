@@ -0,0 +1,107 @@
+package cli
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"carca-cli/internal/bga"
+	"carca-cli/internal/fixtures"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestFixtureModel_RefreshStatus_ShowsRunningScore(t *testing.T) {
+	ctx := context.Background()
+	mockClient := bga.NewMockClient("testuser", "testpass")
+	if err := mockClient.Login(ctx); err != nil {
+		t.Fatalf("Failed to login: %v", err)
+	}
+
+	resp, err := mockClient.CreateSwissTournament(ctx, "Elite", "herchu", "webbi", 1, 3)
+	if err != nil {
+		t.Fatalf("Failed to create tournament: %v", err)
+	}
+
+	if err := mockClient.SimulateMatchResult(resp.TournamentID, 1, 112, 98, "herchu"); err != nil {
+		t.Fatalf("Failed to simulate a game: %v", err)
+	}
+
+	division := &fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, Matches: []*fixtures.Match{
+				{ID: 3, HomePlayer: "herchu", AwayPlayer: "webbi", BGALink: resp.Link},
+			}},
+		},
+	}
+	model := NewFixtureModel(division)
+	model.SetBGAClient(mockClient)
+
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+	if cmd == nil {
+		t.Fatal("Expected r to start a status refresh")
+	}
+
+	_, _ = model.Update(cmd())
+
+	if model.statusMessage != "Duelo 3: in_progress 1-0" {
+		t.Errorf("Expected the running score in the status, got %q", model.statusMessage)
+	}
+}
+
+func TestFixtureModel_RefreshStatus_Errors(t *testing.T) {
+	testCases := []struct {
+		name       string
+		link       string
+		login      bool
+		wantStatus string
+	}{
+		{
+			name:       "not logged in",
+			link:       "https://boardgamearena.com/tournament?id=423762",
+			wantStatus: "not logged in to BGA",
+		},
+		{
+			name:       "unknown tournament",
+			link:       "https://boardgamearena.com/tournament?id=1",
+			login:      true,
+			wantStatus: "no longer exists",
+		},
+		{
+			name:       "no link",
+			wantStatus: "No tournament link",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockClient := bga.NewMockClient("testuser", "testpass")
+			if tc.login {
+				if err := mockClient.Login(context.Background()); err != nil {
+					t.Fatalf("Failed to login: %v", err)
+				}
+			}
+
+			division := &fixtures.Division{
+				Name: "Elite",
+				Rounds: []*fixtures.Round{
+					{Number: 1, Matches: []*fixtures.Match{
+						{ID: 3, HomePlayer: "herchu", AwayPlayer: "webbi", BGALink: tc.link},
+					}},
+				},
+			}
+			model := NewFixtureModel(division)
+			model.SetBGAClient(mockClient)
+
+			_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+			if tc.link != "" {
+				_, _ = model.Update(cmd())
+			}
+
+			if !strings.Contains(model.statusMessage, tc.wantStatus) {
+				t.Errorf("Expected status to mention %q, got %q", tc.wantStatus, model.statusMessage)
+			}
+		})
+	}
+}
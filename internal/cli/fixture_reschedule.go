@@ -0,0 +1,170 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"carca-cli/internal/fixtures"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// handleRescheduleKey handles 'S' key by asking how far to shift the current round's scheduled matches
+func (m *FixtureModel) handleRescheduleKey() (tea.Model, tea.Cmd) {
+	if m.GetCurrentRound() == nil {
+		return m, nil
+	}
+
+	if m.bulk != nil || m.cancelCreate != nil {
+		m.statusMessage = "A tournament creation is already in progress"
+		return m, m.clearStatus()
+	}
+
+	m.rescheduling = true
+	m.rescheduleInput = textinput.New()
+	m.rescheduleInput.Placeholder = "+7d"
+	m.rescheduleInput.CharLimit = 12
+
+	return m, m.rescheduleInput.Focus()
+}
+
+// updateReschedule types the offset, shifting the round on Enter and canceling on Esc
+func (m *FixtureModel) updateReschedule(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEsc:
+		m.rescheduling = false
+		m.statusMessage = "Reschedule canceled"
+		return m, m.clearStatus()
+	case tea.KeyEnter:
+		offset, err := parseDateShift(m.rescheduleInput.Value())
+		if err != nil {
+			m.statusMessage = fmt.Sprintf("Invalid offset: %v", err)
+			return m, nil
+		}
+
+		m.rescheduling = false
+		return m, m.rescheduleRound(offset)
+	}
+
+	var cmd tea.Cmd
+	m.rescheduleInput, cmd = m.rescheduleInput.Update(msg)
+	return m, cmd
+}
+
+// rescheduleRound shifts every unplayed scheduled match of the current round, saves the fixture
+// and re-creates the tournaments already created for the old datetimes
+func (m *FixtureModel) rescheduleRound(offset time.Duration) tea.Cmd {
+	round := m.GetCurrentRound()
+	current := now()
+	recreate := &bulkCreation{}
+	var shifted, skipped int
+
+	for _, match := range round.Matches {
+		if match.Played || match.IsBye() || !match.HasAgreedDateTime() {
+			continue
+		}
+
+		if !match.ShiftDateTime(offset, current.Year()) {
+			skipped++
+			continue
+		}
+		shifted++
+
+		dateTime, err := match.ScheduledTime(current)
+		if match.BGALink == "" || err != nil {
+			continue
+		}
+
+		recreate.queue = append(recreate.queue, createTournamentMsgWithDateTime{
+			dateTime:    dateTime,
+			homePlayer:  match.HomePlayer,
+			awayPlayer:  match.AwayPlayer,
+			division:    m.division.Name,
+			matchID:     match.ID,
+			roundNum:    m.currentRound,
+			matchNumber: match.ID,
+		})
+	}
+
+	m.statusMessage = fmt.Sprintf("Round %d: %d matches moved by %s", round.Number, shifted, formatDateShift(offset))
+	if skipped > 0 {
+		m.statusMessage += fmt.Sprintf(", %d skipped with unreadable dates", skipped)
+	}
+
+	if shifted > 0 && m.division.Filename != "" {
+		if err := fixtures.WriteDivisionFile(m.division, m.division.Filename); err != nil {
+			m.statusMessage += fmt.Sprintf(" (not saved: %v)", err)
+		}
+	}
+
+	if len(recreate.queue) == 0 {
+		return m.clearStatus()
+	}
+
+	return m.startBulk(recreate)
+}
+
+// parseDateShift reads an offset such as "+7d", "-1d", "2h", "1d12h" or "-90m"
+func parseDateShift(value string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+
+	sign := time.Duration(1)
+	switch {
+	case strings.HasPrefix(value, "-"):
+		sign, value = -1, value[1:]
+	case strings.HasPrefix(value, "+"):
+		value = value[1:]
+	}
+
+	var offset time.Duration
+	if days, rest, ok := strings.Cut(value, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("invalid day count %q", days)
+		}
+		offset, value = time.Duration(n)*24*time.Hour, rest
+	}
+
+	if value != "" {
+		d, err := time.ParseDuration(value)
+		if err != nil || d < 0 {
+			return 0, fmt.Errorf("invalid duration %q", value)
+		}
+		offset += d
+	}
+
+	if offset == 0 {
+		return 0, errors.New("expected a non-zero offset like +7d or -2h")
+	}
+
+	return sign * offset, nil
+}
+
+// formatDateShift renders an offset the way it is typed, e.g. "+7d" or "-1d12h0m0s"
+func formatDateShift(offset time.Duration) string {
+	sign := "+"
+	if offset < 0 {
+		sign, offset = "-", -offset
+	}
+
+	days, rest := offset/(24*time.Hour), offset%(24*time.Hour)
+
+	switch {
+	case days == 0:
+		return sign + rest.String()
+	case rest == 0:
+		return fmt.Sprintf("%s%dd", sign, days)
+	default:
+		return fmt.Sprintf("%s%dd%s", sign, days, rest)
+	}
+}
+
+// renderReschedulePrompt shows the offset being typed for the current round
+func (m *FixtureModel) renderReschedulePrompt() string {
+	return fmt.Sprintf("Shift Round %d's scheduled matches by (e.g. +7d, -1d, 2h): %s",
+		m.GetCurrentRound().Number, m.rescheduleInput.View())
+}
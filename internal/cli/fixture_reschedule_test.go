@@ -0,0 +1,152 @@
+package cli
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"carca-cli/internal/bga"
+	"carca-cli/internal/fixtures"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func typeReschedule(model *FixtureModel, offset string) tea.Cmd {
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("S")})
+	for _, r := range offset {
+		model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	return cmd
+}
+
+func TestParseDateShift(t *testing.T) {
+	testCases := map[string]time.Duration{
+		"+7d":   7 * 24 * time.Hour,
+		"7d":    7 * 24 * time.Hour,
+		"-1d":   -24 * time.Hour,
+		"2h":    2 * time.Hour,
+		"1d12h": 36 * time.Hour,
+		"-90m":  -90 * time.Minute,
+	}
+
+	for value, expected := range testCases {
+		offset, err := parseDateShift(value)
+		if err != nil {
+			t.Errorf("Unexpected error for %q: %v", value, err)
+			continue
+		}
+		if offset != expected {
+			t.Errorf("Expected %v for %q, got %v", expected, value, offset)
+		}
+		if formatted, _ := parseDateShift(formatDateShift(offset)); formatted != offset {
+			t.Errorf("Expected %q to format back to the same offset, got %q", value, formatDateShift(offset))
+		}
+	}
+
+	for _, invalid := range []string{"", "+", "0d", "xd", "week", "1d-2h"} {
+		if _, err := parseDateShift(invalid); err == nil {
+			t.Errorf("Expected an error for %q", invalid)
+		}
+	}
+}
+
+func TestFixtureModel_RescheduleRound_ShiftsByAWeek(t *testing.T) {
+	freezeClock(t, time.Date(2025, 8, 1, 12, 0, 0, 0, time.Local))
+
+	model := NewFixtureModel(&fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, DateRange: "25/08 - 31/08", Matches: []*fixtures.Match{
+				{ID: 1, HomePlayer: "herchu", AwayPlayer: "webbi", DateTime: "26/08 - 21:30"},
+				{ID: 2, HomePlayer: "Lord Trooper", AwayPlayer: "alehrosario", DateTime: "30/08 - 18:00"},
+				{ID: 3, HomePlayer: "kuro", AwayPlayer: "zeta", DateTime: "27/08 - 20:00", Played: true},
+				{ID: 4, HomePlayer: "pepe", AwayPlayer: "lola"},
+			}},
+		},
+	})
+
+	typeReschedule(model, "+7d")
+
+	round := model.division.Rounds[0]
+	for i, expected := range []string{"02/09 - 21:30", "06/09 - 18:00", "27/08 - 20:00", ""} {
+		if round.Matches[i].DateTime != expected {
+			t.Errorf("Expected match %d at %q, got %q", round.Matches[i].ID, expected, round.Matches[i].DateTime)
+		}
+	}
+	if model.rescheduling {
+		t.Error("Expected the prompt to close after shifting")
+	}
+	if !strings.Contains(model.statusMessage, "2 matches moved by +7d") {
+		t.Errorf("Expected a summary of the shift, got %q", model.statusMessage)
+	}
+}
+
+func TestFixtureModel_RescheduleRound_RecreatesTournaments(t *testing.T) {
+	freezeClock(t, time.Date(2025, 8, 1, 12, 0, 0, 0, time.Local))
+	stubClipboard(t)
+
+	oldLink := "https://boardgamearena.com/tournament?id=423761"
+	model := NewFixtureModel(&fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, Matches: []*fixtures.Match{
+				{ID: 1, HomePlayer: "herchu", AwayPlayer: "webbi", DateTime: "26/08 - 21:30", BGALink: oldLink},
+			}},
+		},
+	})
+
+	mockClient := bga.NewMockClient("testuser", "testpass")
+	if err := mockClient.Login(context.Background()); err != nil {
+		t.Fatalf("Failed to login mock client: %v", err)
+	}
+	model.SetBGAClient(mockClient)
+
+	cmd := typeReschedule(model, "-1d")
+	if model.bulk == nil {
+		t.Fatal("Expected the existing tournament to be re-created")
+	}
+	if queued := model.bulk.current.dateTime; !queued.Equal(time.Date(2025, 8, 25, 21, 30, 0, 0, time.Local)) {
+		t.Errorf("Expected the tournament to be re-created for the new datetime, got %v", queued)
+	}
+
+	runBulkCreation(t, model, cmd)
+
+	match := model.division.Rounds[0].Matches[0]
+	if match.DateTime != "25/08 - 21:30" {
+		t.Errorf("Expected the match moved a day earlier, got %q", match.DateTime)
+	}
+	if match.BGALink == oldLink || match.BGALink == "" {
+		t.Errorf("Expected a new tournament link, got %q", match.BGALink)
+	}
+}
+
+func TestFixtureModel_RescheduleRound_InvalidOffsetKeepsPrompt(t *testing.T) {
+	model := NewFixtureModel(&fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, Matches: []*fixtures.Match{
+				{ID: 1, HomePlayer: "herchu", AwayPlayer: "webbi", DateTime: "26/08 - 21:30"},
+			}},
+		},
+	})
+
+	typeReschedule(model, "soon")
+
+	if !model.rescheduling || !model.capturingInput() {
+		t.Error("Expected the prompt to stay open after an invalid offset")
+	}
+	if !strings.HasPrefix(model.statusMessage, "Invalid offset") {
+		t.Errorf("Expected an invalid offset status, got %q", model.statusMessage)
+	}
+	if !strings.Contains(model.View(), "Shift Round 1") {
+		t.Errorf("Expected the prompt in the view, got:\n%s", model.View())
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if model.rescheduling || model.division.Rounds[0].Matches[0].DateTime != "26/08 - 21:30" {
+		t.Error("Expected esc to cancel without moving the match")
+	}
+}
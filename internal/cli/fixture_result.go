@@ -0,0 +1,111 @@
+package cli
+
+import (
+	"fmt"
+
+	"carca-cli/internal/fixtures"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// quickResults are the usual best-of-3 outcomes offered by the result prompt, picked by their key
+var quickResults = []struct {
+	key       string
+	homeScore int
+	awayScore int
+}{
+	{key: "1", homeScore: 2, awayScore: 0},
+	{key: "2", homeScore: 2, awayScore: 1},
+	{key: "3", homeScore: 1, awayScore: 2},
+	{key: "4", homeScore: 0, awayScore: 2},
+}
+
+// handleResultKey handles 'e' key by asking for the selected match's result
+func (m *FixtureModel) handleResultKey() (tea.Model, tea.Cmd) {
+	match := m.selectedFixtureMatch()
+	if match == nil {
+		return m, nil
+	}
+
+	switch {
+	case match.IsBye():
+		m.statusMessage = "No result to enter for a bye"
+		return m, m.clearStatus()
+	case missingPlayerName(match.HomePlayer, match.AwayPlayer):
+		m.statusMessage = missingPlayerStatus
+		return m, m.clearStatus()
+	case match.Played:
+		m.statusMessage = "Match already played"
+		return m, m.clearStatus()
+	}
+
+	m.resultPrompt = true
+	m.statusMessage = resultPromptText(match)
+
+	return m, nil
+}
+
+// resultPromptText lists the quick-keys of the result prompt for a match
+func resultPromptText(match *fixtures.Match) string {
+	return fmt.Sprintf("Result for Duelo %d: (1) 2-0 %s, (2) 2-1 %s, (3) 1-2 %s, (4) 0-2 %s? Any other key cancels",
+		match.ID, match.HomePlayer, match.HomePlayer, match.AwayPlayer, match.AwayPlayer)
+}
+
+// handleResultChoice records the quick result for the pressed key and moves on to the round's next unplayed match,
+// keeping the prompt open until the round runs out of them
+func (m *FixtureModel) handleResultChoice(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	m.resultPrompt = false
+
+	match := m.selectedFixtureMatch()
+	if match == nil {
+		return m, nil
+	}
+
+	chosen := -1
+	for i, result := range quickResults {
+		if msg.String() == result.key {
+			chosen = i
+		}
+	}
+	if chosen < 0 {
+		m.statusMessage = "Result entry canceled"
+		return m, m.clearStatus()
+	}
+
+	result := quickResults[chosen]
+	if err := match.RecordResult(result.homeScore, result.awayScore); err != nil {
+		m.statusMessage = fmt.Sprintf("Result failed: %v", err)
+		return m, m.clearStatus()
+	}
+
+	m.statusMessage = fmt.Sprintf("Duelo %d: %s %d-%d %s", match.ID,
+		match.HomePlayer, match.HomeScore, match.AwayScore, match.AwayPlayer)
+
+	if m.division.Filename != "" {
+		if err := fixtures.WriteDivisionFile(m.division, m.division.Filename); err != nil {
+			m.statusMessage += fmt.Sprintf(" (not saved: %v)", err)
+		}
+	}
+
+	if next := m.nextUnplayedInRound(); next >= 0 {
+		m.selectedMatch = next
+		m.resultPrompt = true
+		m.statusMessage += " • " + resultPromptText(m.GetCurrentRound().Matches[next])
+		return m, nil
+	}
+
+	return m, m.clearStatus()
+}
+
+// nextUnplayedInRound returns the index of the next match after the selected one that can take a result, or -1
+func (m *FixtureModel) nextUnplayedInRound() int {
+	round := m.GetCurrentRound()
+	for i := m.selectedMatch + 1; i < len(round.Matches); i++ {
+		match := round.Matches[i]
+		if !match.Played && !match.IsBye() && !missingPlayerName(match.HomePlayer, match.AwayPlayer) {
+			return i
+		}
+	}
+
+	return -1
+}
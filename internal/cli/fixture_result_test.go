@@ -0,0 +1,122 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"carca-cli/internal/fixtures"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestFixtureModel_QuickResult_TwoOneHome(t *testing.T) {
+	data := "Duelo,Fecha 1,,,,11/08 - 17/08,Detalles,,¿Se jugó?,¿Ganó Local?,¿Ganó Visita?,,,\n" +
+		"1,herchu,0,0,Lord Trooper,-,,,0,0,0,,,\n" +
+		"2,webbi,2,0,alehrosario,-,,,1,1,0,,,\n" +
+		"3,Tinchox,0,0,Nanami,-,,,0,0,0,,,\n"
+
+	filename := filepath.Join(t.TempDir(), "Liga Argentina - 1° Temporada - E-Fixture.csv")
+	if err := os.WriteFile(filename, []byte(data), 0o600); err != nil {
+		t.Fatalf("Failed to write fixture: %v", err)
+	}
+
+	division, err := fixtures.ParseFixtureFile(filename)
+	if err != nil {
+		t.Fatalf("Failed to parse fixture: %v", err)
+	}
+
+	model := NewFixtureModel(division)
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
+	if !model.resultPrompt || !strings.Contains(model.statusMessage, "(2) 2-1 herchu") {
+		t.Fatalf("Expected the quick result prompt, got: %s", model.statusMessage)
+	}
+
+	// '2' is the 2-1 home quick-key
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'2'}})
+
+	match := division.Rounds[0].Matches[0]
+	if !match.Played || match.HomeScore != 2 || match.AwayScore != 1 {
+		t.Errorf("Expected a played 2-1 result, got %+v", match)
+	}
+	if match.HomeScore <= match.AwayScore {
+		t.Error("Expected the home player to win")
+	}
+
+	// The played Duelo 2 is skipped, so the prompt moves on to Duelo 3
+	if model.selectedMatch != 2 || !model.resultPrompt {
+		t.Errorf("Expected the prompt to advance to Duelo 3, got match index %d (prompt %v)",
+			model.selectedMatch, model.resultPrompt)
+	}
+	if !strings.Contains(model.statusMessage, "Duelo 1: herchu 2-1 Lord Trooper") ||
+		!strings.Contains(model.statusMessage, "Result for Duelo 3") {
+		t.Errorf("Expected the recorded result and the next prompt, got: %s", model.statusMessage)
+	}
+
+	reloaded, err := fixtures.ParseFixtureFile(filename)
+	if err != nil {
+		t.Fatalf("Failed to reload fixture: %v", err)
+	}
+	if saved := reloaded.Rounds[0].Matches[0]; !saved.Played || saved.HomeScore != 2 || saved.AwayScore != 1 {
+		t.Errorf("Expected the result written back to the CSV, got %+v", saved)
+	}
+
+	// Any other key ends the entry without touching Duelo 3
+	model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if model.resultPrompt || model.statusMessage != "Result entry canceled" {
+		t.Errorf("Expected esc to cancel the entry, got: %s", model.statusMessage)
+	}
+	if division.Rounds[0].Matches[2].Played {
+		t.Error("Expected Duelo 3 to stay unplayed")
+	}
+}
+
+func TestFixtureModel_QuickResult_LastMatchClosesPrompt(t *testing.T) {
+	model := NewFixtureModel(&fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, Matches: []*fixtures.Match{{ID: 1, HomePlayer: "herchu", AwayPlayer: "webbi"}}},
+		},
+	})
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'4'}})
+
+	match := model.division.Rounds[0].Matches[0]
+	if match.HomeScore != 0 || match.AwayScore != 2 {
+		t.Errorf("Expected a 0-2 away win, got %d-%d", match.HomeScore, match.AwayScore)
+	}
+	if model.resultPrompt {
+		t.Error("Expected the prompt to close after the round's last unplayed match")
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
+	if model.resultPrompt || model.statusMessage != "Match already played" {
+		t.Errorf("Expected a played match to refuse a result, got: %s", model.statusMessage)
+	}
+}
+
+func TestFixtureModel_QuickResult_SkipsMissingPlayerName(t *testing.T) {
+	model := NewFixtureModel(&fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, Matches: []*fixtures.Match{
+				{ID: 1, HomePlayer: "herchu", AwayPlayer: "webbi"},
+				{ID: 2, HomePlayer: "", AwayPlayer: " "},
+			}},
+		},
+	})
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'1'}})
+	if model.resultPrompt || model.selectedMatch != 0 {
+		t.Errorf("Expected the prompt to skip the match without player names, got match %d", model.selectedMatch)
+	}
+
+	model.selectedMatch = 1
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
+	if model.resultPrompt || model.statusMessage != missingPlayerStatus {
+		t.Errorf("Expected the match without player names to refuse a result, got: %s", model.statusMessage)
+	}
+}
@@ -0,0 +1,142 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"carca-cli/internal/fixtures"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// matchRef points at a match by its round and position in that round
+type matchRef struct {
+	round int
+	index int
+}
+
+// handleSearchKey handles '/' key to search matches by player across every round
+func (m *FixtureModel) handleSearchKey() (tea.Model, tea.Cmd) {
+	m.searching = true
+	m.searchResults = nil
+	m.searchCursor = 0
+	m.searchInput = textinput.New()
+	m.searchInput.Placeholder = "player name"
+	m.searchInput.CharLimit = 40
+
+	return m, m.searchInput.Focus()
+}
+
+// updateSearch types into the search, moving through the results with ↑/↓ and jumping to one on Enter
+func (m *FixtureModel) updateSearch(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEsc:
+		m.searching = false
+		return m, nil
+	case tea.KeyEnter:
+		m.jumpToSearchResult()
+		return m, nil
+	case tea.KeyUp:
+		m.moveSearchCursor(-1)
+		return m, nil
+	case tea.KeyDown:
+		m.moveSearchCursor(1)
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.searchInput, cmd = m.searchInput.Update(msg)
+	m.searchResults = m.findMatches(m.searchInput.Value())
+	m.searchCursor = 0
+
+	return m, cmd
+}
+
+// findMatches lists the matches of every round with a player whose name contains the query, ignoring case
+func (m *FixtureModel) findMatches(query string) []matchRef {
+	query = strings.ToLower(strings.TrimSpace(query))
+	if query == "" {
+		return nil
+	}
+
+	var results []matchRef
+	for roundIndex, round := range m.division.Rounds {
+		for matchIndex, match := range round.Matches {
+			if strings.Contains(strings.ToLower(match.HomePlayer), query) ||
+				strings.Contains(strings.ToLower(match.AwayPlayer), query) {
+				results = append(results, matchRef{round: roundIndex, index: matchIndex})
+			}
+		}
+	}
+
+	return results
+}
+
+// filterMatches returns the matches found by findMatches, in round order
+func (m *FixtureModel) filterMatches(query string) []*fixtures.Match {
+	refs := m.findMatches(query)
+	matches := make([]*fixtures.Match, 0, len(refs))
+	for _, ref := range refs {
+		matches = append(matches, m.division.Rounds[ref.round].Matches[ref.index])
+	}
+
+	return matches
+}
+
+// moveSearchCursor moves the selection through the search results, wrapping at both ends
+func (m *FixtureModel) moveSearchCursor(direction int) {
+	if len(m.searchResults) == 0 {
+		return
+	}
+
+	m.searchCursor = (m.searchCursor + direction + len(m.searchResults)) % len(m.searchResults)
+}
+
+// jumpToSearchResult selects the highlighted result in its round and closes the search
+// With nothing found the search just closes, keeping the selection
+func (m *FixtureModel) jumpToSearchResult() {
+	m.searching = false
+	if m.searchCursor >= len(m.searchResults) {
+		return
+	}
+
+	ref := m.searchResults[m.searchCursor]
+	m.currentRound, m.selectedMatch = ref.round, ref.index
+}
+
+// renderSearch shows the search input above the flat list of matching matches
+func (m *FixtureModel) renderSearch() string {
+	title := m.style.Render(fmt.Sprintf("Division %s - Search", m.division.Name))
+	s := fmt.Sprintf("\n%s\n\n/%s\n\n", title, m.searchInput.View())
+
+	if len(m.searchResults) == 0 && m.searchInput.Value() != "" {
+		s += lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#FF6B6B")).
+			Render("No matches found")
+		s += "\n"
+	}
+
+	for i, ref := range m.searchResults {
+		round := m.division.Rounds[ref.round]
+		match := round.Matches[ref.index]
+
+		cursor := " "
+		line := fmt.Sprintf("Round %d - Duelo %d: %s vs %s", round.Number, match.ID, match.HomePlayer, match.AwayPlayer)
+		if match.Played {
+			line += " (played)"
+		}
+		if i == m.searchCursor {
+			cursor = ">"
+			line = m.style.Render(line)
+		}
+
+		s += fmt.Sprintf("%s %s\n", cursor, line)
+	}
+
+	s += "\n" + wrapToWidth("Type a player name, ↑/↓ to select a match, enter to jump to it, esc to cancel.", m.width)
+	s += "\n"
+
+	return s
+}
@@ -0,0 +1,130 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"carca-cli/internal/fixtures"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func searchTestDivision() *fixtures.Division {
+	return &fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, Matches: []*fixtures.Match{
+				{ID: 1, HomePlayer: "herchu", AwayPlayer: "webbi", Played: true, HomeScore: 2, AwayScore: 1},
+				{ID: 2, HomePlayer: "Lord Trooper", AwayPlayer: "alehrosario"},
+			}},
+			{Number: 2, Matches: []*fixtures.Match{
+				{ID: 3, HomePlayer: "alehrosario", AwayPlayer: "webbi"},
+				{ID: 4, HomePlayer: "herchu", AwayPlayer: "Lord Trooper"},
+			}},
+		},
+	}
+}
+
+func typeSearch(model *FixtureModel, query string) {
+	_, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("/")})
+	for _, r := range query {
+		_, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+}
+
+func TestFixtureModel_Search_ListsResultsAcrossRounds(t *testing.T) {
+	model := NewFixtureModel(searchTestDivision())
+
+	typeSearch(model, "WEBBI")
+
+	want := []matchRef{{round: 0, index: 0}, {round: 1, index: 0}}
+	if len(model.searchResults) != len(want) {
+		t.Fatalf("Expected %d results, got %v", len(want), model.searchResults)
+	}
+	for i, ref := range want {
+		if model.searchResults[i] != ref {
+			t.Errorf("Expected result %d to be %+v, got %+v", i, ref, model.searchResults[i])
+		}
+	}
+
+	view := model.View()
+	for _, line := range []string{"Round 1 - Duelo 1: herchu vs webbi", "Round 2 - Duelo 3: alehrosario vs webbi"} {
+		if !strings.Contains(view, line) {
+			t.Errorf("Expected the flat list to contain %q, got:\n%s", line, view)
+		}
+	}
+}
+
+func TestFixtureModel_Search_EnterJumpsToCrossRoundResult(t *testing.T) {
+	model := NewFixtureModel(searchTestDivision())
+
+	typeSearch(model, "trooper")
+	_, _ = model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	_, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	if model.searching {
+		t.Error("Expected Enter to close the search")
+	}
+	if model.currentRound != 1 || model.selectedMatch != 1 {
+		t.Errorf("Expected round 2, match 2 to be selected, got round index %d, match index %d",
+			model.currentRound, model.selectedMatch)
+	}
+	if match := model.selectedFixtureMatch(); match == nil || match.ID != 4 {
+		t.Errorf("Expected Duelo 4 to be selected, got %+v", match)
+	}
+}
+
+func TestFixtureModel_Search_KeysTypeInsteadOfActing(t *testing.T) {
+	model := NewFixtureModel(searchTestDivision())
+
+	typeSearch(model, "q")
+
+	if !model.searching || model.searchInput.Value() != "q" {
+		t.Errorf("Expected q to be typed into the search, got %q (searching %v)",
+			model.searchInput.Value(), model.searching)
+	}
+
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if model.searching || cmd != nil {
+		t.Error("Expected esc to only close the search")
+	}
+	if model.currentRound != 0 || model.selectedMatch != 0 {
+		t.Error("Expected a canceled search to keep the selection")
+	}
+}
+
+func TestFixtureModel_FilterMatches(t *testing.T) {
+	model := NewFixtureModel(searchTestDivision())
+
+	matches := model.filterMatches("herchu")
+	if len(matches) != 2 {
+		t.Fatalf("Expected herchu's 2 matches, got %d", len(matches))
+	}
+	for i, wantID := range []int{1, 4} {
+		match := matches[i]
+		if match.ID != wantID {
+			t.Errorf("Expected match %d to be Duelo %d, got Duelo %d", i, wantID, match.ID)
+		}
+		if match.HomePlayer != "herchu" && match.AwayPlayer != "herchu" {
+			t.Errorf("Expected only herchu's matches, got %s vs %s", match.HomePlayer, match.AwayPlayer)
+		}
+	}
+
+	if matches := model.filterMatches("  "); len(matches) != 0 {
+		t.Errorf("Expected a blank query to match nothing, got %d matches", len(matches))
+	}
+}
+
+func TestFixtureModel_Search_EnterWithoutResultsCloses(t *testing.T) {
+	model := NewFixtureModel(searchTestDivision())
+
+	typeSearch(model, "nobody")
+	_, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	if model.searching {
+		t.Error("Expected Enter to close a search with no results")
+	}
+	if model.currentRound != 0 || model.selectedMatch != 0 {
+		t.Error("Expected a search with no results to keep the selection")
+	}
+}
@@ -0,0 +1,74 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"carca-cli/internal/bga"
+	"carca-cli/internal/fixtures"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// handleShareResult handles 's' key to copy a result blurb of the selected match for Twitter or Discord
+func (m *FixtureModel) handleShareResult() (tea.Model, tea.Cmd) {
+	match := m.selectedFixtureMatch()
+	round := m.GetCurrentRound()
+
+	switch {
+	case match == nil || round == nil:
+		m.statusMessage = "No match selected"
+	case !match.Played:
+		m.statusMessage = "No result to share yet"
+	case clipboardWriteAll(resultSummary(match, round.Number)) != nil:
+		m.statusMessage = "Failed to copy result to clipboard"
+	default:
+		m.statusMessage = "Result copied to clipboard"
+	}
+
+	return m, m.clearStatus()
+}
+
+// handleCopyTournamentID handles 'y' key to copy only the numeric tournament ID of the selected match's link
+func (m *FixtureModel) handleCopyTournamentID() (tea.Model, tea.Cmd) {
+	match := m.selectedFixtureMatch()
+	if match == nil || match.BGALink == "" {
+		m.statusMessage = "No tournament link for this match"
+		return m, m.clearStatus()
+	}
+
+	id, err := bga.ExtractTournamentID(match.BGALink)
+	switch {
+	case err != nil:
+		m.statusMessage = fmt.Sprintf("No tournament ID in link: %v", err)
+	case clipboardWriteAll(strconv.Itoa(id)) != nil:
+		m.statusMessage = "Failed to copy tournament ID to clipboard"
+	default:
+		m.statusMessage = "Tournament ID copied"
+	}
+
+	return m, m.clearStatus()
+}
+
+// resultSummary formats a played match as a one-line result, winner first with their score leading
+// e.g. "🏆 Duelo 5 (R5): herchu def. Lord Trooper 2-1"
+func resultSummary(match *fixtures.Match, roundNumber int) string {
+	prefix := fmt.Sprintf("Duelo %d (R%d):", match.ID, roundNumber)
+	home, away := match.HomePlayer, match.AwayPlayer
+	winner, decided := match.Winner()
+
+	switch {
+	case match.HomeForfeited() && match.AwayForfeited():
+		return fmt.Sprintf("%s %s vs %s, both forfeited", prefix, home, away)
+	case match.AwayForfeited():
+		return fmt.Sprintf("🏆 %s %s def. %s by walkover", prefix, home, away)
+	case match.HomeForfeited():
+		return fmt.Sprintf("🏆 %s %s def. %s by walkover", prefix, away, home)
+	case decided && winner == home:
+		return fmt.Sprintf("🏆 %s %s def. %s %d-%d", prefix, home, away, match.HomeScore, match.AwayScore)
+	case decided:
+		return fmt.Sprintf("🏆 %s %s def. %s %d-%d", prefix, away, home, match.AwayScore, match.HomeScore)
+	default:
+		return fmt.Sprintf("🤝 %s %s drew with %s %d-%d", prefix, home, away, match.HomeScore, match.AwayScore)
+	}
+}
@@ -0,0 +1,109 @@
+package cli
+
+import (
+	"testing"
+
+	"carca-cli/internal/fixtures"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestResultSummary(t *testing.T) {
+	testCases := []struct {
+		match    *fixtures.Match
+		name     string
+		expected string
+	}{
+		{
+			&fixtures.Match{ID: 5, HomePlayer: "herchu", AwayPlayer: "Lord Trooper", HomeScore: 2, AwayScore: 1, Played: true},
+			"home win", "🏆 Duelo 5 (R5): herchu def. Lord Trooper 2-1",
+		},
+		{
+			&fixtures.Match{ID: 5, HomePlayer: "herchu", AwayPlayer: "Lord Trooper", HomeScore: 0, AwayScore: 2, Played: true},
+			"away win", "🏆 Duelo 5 (R5): Lord Trooper def. herchu 2-0",
+		},
+		{
+			&fixtures.Match{ID: 5, HomePlayer: "herchu", AwayPlayer: "Lord Trooper", HomeScore: 1, AwayScore: 1, Played: true},
+			"draw", "🤝 Duelo 5 (R5): herchu drew with Lord Trooper 1-1",
+		},
+		{
+			&fixtures.Match{
+				ID: 5, HomePlayer: "herchu", AwayPlayer: "Lord Trooper",
+				HomeScore: fixtures.ForfeitScore, AwayScore: 0, Played: true,
+			},
+			"walkover", "🏆 Duelo 5 (R5): Lord Trooper def. herchu by walkover",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := resultSummary(tc.match, 5); got != tc.expected {
+				t.Errorf("Expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestFixtureModel_ShareResult(t *testing.T) {
+	copied := stubClipboard(t)
+
+	model := NewFixtureModel(&fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 5, Matches: []*fixtures.Match{
+				{ID: 5, HomePlayer: "herchu", AwayPlayer: "Lord Trooper", HomeScore: 1, AwayScore: 2, Played: true},
+				{ID: 6, HomePlayer: "webbi", AwayPlayer: "alehrosario"},
+			}},
+		},
+	})
+
+	shareKey := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")}
+
+	model.Update(shareKey)
+	if len(*copied) != 1 || (*copied)[0] != "🏆 Duelo 5 (R5): Lord Trooper def. herchu 2-1" {
+		t.Fatalf("Expected the away win blurb to be copied, got %v", *copied)
+	}
+	if model.statusMessage != "Result copied to clipboard" {
+		t.Errorf("Expected a copied status, got %q", model.statusMessage)
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	model.Update(shareKey)
+	if len(*copied) != 1 {
+		t.Errorf("Expected nothing copied for an unplayed match, got %v", *copied)
+	}
+	if model.statusMessage != "No result to share yet" {
+		t.Errorf("Expected an unplayed status, got %q", model.statusMessage)
+	}
+}
+
+func TestFixtureModel_CopyTournamentID(t *testing.T) {
+	copied := stubClipboard(t)
+
+	model := NewFixtureModel(&fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, Matches: []*fixtures.Match{
+				{
+					ID: 1, HomePlayer: "herchu", AwayPlayer: "webbi",
+					BGALink: "https://boardgamearena.com/tournament?id=423761&from=lobby",
+				},
+				{ID: 2, HomePlayer: "Lord Trooper", AwayPlayer: "alehrosario"},
+			}},
+		},
+	})
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	if len(*copied) != 1 || (*copied)[0] != "423761" {
+		t.Errorf("Expected exactly the numeric ID to be copied, got %q", *copied)
+	}
+	if model.statusMessage != "Tournament ID copied" {
+		t.Errorf("Expected copy status, got: %s", model.statusMessage)
+	}
+
+	model.selectedMatch = 1
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	if len(*copied) != 1 || model.statusMessage != "No tournament link for this match" {
+		t.Errorf("Expected nothing copied without a link, got %q (%s)", *copied, model.statusMessage)
+	}
+}
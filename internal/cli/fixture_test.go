@@ -1,6 +1,11 @@
 package cli
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -8,7 +13,10 @@ import (
 	"carca-cli/internal/bga"
 	"carca-cli/internal/fixtures"
 
+	"github.com/charmbracelet/bubbles/spinner"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
 )
 
 func TestFixtureModel_Init(t *testing.T) {
@@ -47,6 +55,8 @@ func TestFixtureModel_Init(t *testing.T) {
 }
 
 func TestFixtureModel_Update_Navigation(t *testing.T) {
+	freezeOffSeason(t)
+
 	division := &fixtures.Division{
 		Name: "Elite",
 		Rounds: []*fixtures.Round{
@@ -217,12 +227,263 @@ func TestFixtureModel_View_ShowsPlayedStatus(t *testing.T) {
 	view := model.View()
 
 	// Should indicate played vs unplayed status differently
-	// We'll check this in the implementation - played matches might be green, unplayed might be red
+	// Row colors are checked in TestFixtureModel_View_ColorsRowsByPlayedStatus
 	if !strings.Contains(view, "herchu") || !strings.Contains(view, "webbi") {
 		t.Errorf("Expected view to contain both players, got: %s", view)
 	}
 }
 
+// forceTrueColor renders lipgloss colors even though tests do not run in a terminal
+func forceTrueColor(t *testing.T) {
+	t.Helper()
+
+	original := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	t.Cleanup(func() { lipgloss.SetColorProfile(original) })
+}
+
+// freezeOffSeason freezes the clock before any fixture round so new models open on the first round
+func freezeOffSeason(t *testing.T) {
+	t.Helper()
+	freezeClock(t, time.Date(2025, 7, 1, 12, 0, 0, 0, time.Local))
+}
+
+// runCmd executes cmd and returns its message, skipping the spinner tick batched with tournament creation
+func runCmd(cmd tea.Cmd) tea.Msg {
+	msg := cmd()
+	batch, ok := msg.(tea.BatchMsg)
+	if !ok {
+		return msg
+	}
+
+	for _, batched := range batch {
+		if batched == nil {
+			continue
+		}
+		if msg := batched(); msg != nil {
+			if _, tick := msg.(spinner.TickMsg); !tick {
+				return msg
+			}
+		}
+	}
+
+	return nil
+}
+
+func TestFixtureModel_View_ColorsRowsByPlayedStatus(t *testing.T) {
+	forceTrueColor(t)
+
+	division := &fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, DateRange: "11/08 - 17/08", Matches: []*fixtures.Match{
+				{ID: 1, HomePlayer: "herchu", AwayPlayer: "Lord Trooper", Played: true, HomeScore: 2, AwayScore: 1},
+				{ID: 2, HomePlayer: "webbi", AwayPlayer: "alehrosario"},
+				{ID: 3, HomePlayer: "pepe", AwayPlayer: "tito"},
+			}},
+		},
+	}
+
+	model := NewFixtureModel(division)
+	model.selectedMatch = 2
+
+	const (
+		green     = "38;2;80;200;120"
+		red       = "38;2;255;107;107"
+		highlight = "48;2;125;86;243"
+	)
+
+	lines := map[string]string{}
+	for _, line := range strings.Split(model.View(), "\n") {
+		for _, player := range []string{"herchu", "webbi", "pepe"} {
+			if strings.Contains(line, player) {
+				lines[player] = line
+			}
+		}
+	}
+
+	if !strings.Contains(lines["herchu"], green) {
+		t.Errorf("Expected the played row in green, got %q", lines["herchu"])
+	}
+	if !strings.Contains(lines["webbi"], red) {
+		t.Errorf("Expected the unplayed row in red, got %q", lines["webbi"])
+	}
+	if !strings.Contains(lines["pepe"], highlight) || strings.Contains(lines["pepe"], red) {
+		t.Errorf("Expected the selected row highlight to win over red, got %q", lines["pepe"])
+	}
+}
+
+func TestFixtureModel_View_ShowsForfeitAsF(t *testing.T) {
+	division := &fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, DateRange: "11/08 - 17/08", Matches: []*fixtures.Match{
+				{ID: 1, HomePlayer: "herchu", AwayPlayer: "webbi", HomeScore: 2, AwayScore: fixtures.ForfeitScore, Played: true},
+			}},
+		},
+	}
+
+	view := NewFixtureModel(division).View()
+
+	if !strings.Contains(view, "2-F") {
+		t.Errorf("Expected forfeit result shown as '2-F', got: %s", view)
+	}
+}
+
+func TestFixtureModel_ByeIsShownAndNeverCreated(t *testing.T) {
+	division := &fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, DateRange: "11/08 - 17/08", Matches: []*fixtures.Match{
+				{ID: 1, HomePlayer: "herchu", AwayPlayer: "BYE"},
+				{ID: 2, HomePlayer: "webbi", AwayPlayer: "alehrosario"},
+			}},
+		},
+	}
+	model := NewFixtureModel(division)
+
+	if view := model.View(); !strings.Contains(view, "BYE") {
+		t.Errorf("Expected the bye rendered as 'BYE', got: %s", view)
+	}
+
+	updatedModel, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
+	fixtureModel := updatedModel.(*FixtureModel)
+
+	if fixtureModel.showDatePicker {
+		t.Error("Expected no datetime picker for a bye")
+	}
+	if fixtureModel.statusMessage != "No tournament is needed for a bye" {
+		t.Errorf("Expected the bye to be refused, got status %q", fixtureModel.statusMessage)
+	}
+}
+
+func TestFixtureModel_ByeWithBlankOpponentIsRefusedAsBye(t *testing.T) {
+	division := &fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, DateRange: "11/08 - 17/08", Matches: []*fixtures.Match{
+				{ID: 1, HomePlayer: "herchu", AwayPlayer: ""},
+			}},
+		},
+	}
+	model := NewFixtureModel(division)
+
+	updatedModel, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
+
+	if got := updatedModel.(*FixtureModel).statusMessage; got != "No tournament is needed for a bye" {
+		t.Errorf("Expected the bye message rather than a missing name, got status %q", got)
+	}
+	if view := updatedModel.View(); !strings.Contains(view, "BYE") {
+		t.Errorf("Expected the blank opponent shown as BYE, got:\n%s", view)
+	}
+}
+
+func TestFixtureModel_MissingPlayerNameBlocksCreation(t *testing.T) {
+	division := &fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, DateRange: "11/08 - 17/08", Matches: []*fixtures.Match{
+				{ID: 1, HomePlayer: " ", AwayPlayer: ""},
+			}},
+		},
+	}
+	model := NewFixtureModel(division)
+
+	updatedModel, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
+	fixtureModel := updatedModel.(*FixtureModel)
+
+	if fixtureModel.showDatePicker {
+		t.Error("Expected no datetime picker for a match with a missing player")
+	}
+	if fixtureModel.statusMessage != "Match has a missing player name" {
+		t.Errorf("Expected creation blocked, got status %q", fixtureModel.statusMessage)
+	}
+}
+
+func TestFixtureModel_ConfirmedWithMissingPlayerIsNotCreated(t *testing.T) {
+	model := NewFixtureModel(&fixtures.Division{Name: "Elite"})
+	model.showConfirmation = true
+
+	model.Update(TournamentConfirmedMsg{HomePlayer: "herchu", AwayPlayer: "", MatchID: 1, RoundNumber: 1})
+
+	if model.statusMessage != "Match has a missing player name" {
+		t.Errorf("Expected creation blocked, got status %q", model.statusMessage)
+	}
+}
+
+func TestFixtureModel_View_ShowsSelectedGameScores(t *testing.T) {
+	division := &fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, DateRange: "11/08 - 17/08", Matches: []*fixtures.Match{
+				{ID: 1, HomePlayer: "herchu", AwayPlayer: "webbi", HomeScore: 2, AwayScore: 1, Played: true,
+					GameScores: []string{"75-60", "55-70", "80-50"}},
+				{ID: 2, HomePlayer: "Lord Trooper", AwayPlayer: "alehrosario", HomeScore: 2, AwayScore: 0, Played: true},
+			}},
+		},
+	}
+	model := NewFixtureModel(division)
+
+	if view := model.View(); !strings.Contains(view, "Duelo 1 games: 75-60, 55-70, 80-50") {
+		t.Errorf("Expected the selected match's game scores, got: %s", view)
+	}
+
+	model.selectedMatch = 1
+	if view := model.View(); strings.Contains(view, "games:") {
+		t.Errorf("Expected no game scores for a match without detail, got: %s", view)
+	}
+}
+
+func TestFixtureModel_View_AllTournamentsCreatedBadge(t *testing.T) {
+	const badge = "✓ All tournaments created for this round"
+	const link = "https://boardgamearena.com/tournament?id="
+	division := &fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, DateRange: "11/08 - 17/08", Matches: []*fixtures.Match{
+				{ID: 1, HomePlayer: "herchu", AwayPlayer: "webbi", BGALink: link + "423761"},
+				{ID: 2, HomePlayer: "Lord Trooper", AwayPlayer: "alehrosario", BGALink: link + "423762"},
+			}},
+			{Number: 2, DateRange: "18/08 - 24/08", Matches: []*fixtures.Match{
+				{ID: 3, HomePlayer: "herchu", AwayPlayer: "alehrosario", BGALink: link + "423763"},
+				{ID: 4, HomePlayer: "webbi", AwayPlayer: "Lord Trooper"},
+			}},
+		},
+	}
+	model := NewFixtureModel(division)
+	model.currentRound = 0
+
+	if view := model.View(); !strings.Contains(view, badge) {
+		t.Errorf("Expected the badge on a fully linked round, got: %s", view)
+	}
+
+	model.currentRound = 1
+	if view := model.View(); strings.Contains(view, badge) {
+		t.Errorf("Expected no badge on a partially linked round, got: %s", view)
+	}
+}
+
+func TestFixtureModel_View_ProblemsBannerSummarizesTheRest(t *testing.T) {
+	model := NewFixtureModel(&fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, Matches: []*fixtures.Match{{ID: 1, HomePlayer: "herchu", AwayPlayer: "webbi"}}},
+		},
+	})
+
+	if strings.Contains(model.View(), "Fixture problems") {
+		t.Error("Expected no banner for a valid fixture")
+	}
+
+	model.SetValidationProblems(fixtures.ValidateDivision(&fixtures.Division{
+		Rounds: []*fixtures.Round{{Number: 1, Matches: []*fixtures.Match{{ID: 6}}}},
+	}))
+	view := model.View()
+	if !strings.Contains(view, "match 1 is missing") || !strings.Contains(view, "(and 3 more, see the log with L)") {
+		t.Errorf("Expected the first problems and a count of the rest, got:\n%s", view)
+	}
+}
+
 func TestFixtureModel_View_ShowsNavigation(t *testing.T) {
 	division := &fixtures.Division{
 		Name: "Elite",
@@ -314,29 +575,28 @@ func TestFixtureModel_View_ShowsTableFormat(t *testing.T) {
 	}
 }
 
-func TestFixtureModel_ExtractTournamentID(t *testing.T) {
-	model := NewFixtureModel(&fixtures.Division{})
-
+func TestTournamentIDLabel(t *testing.T) {
 	testCases := []struct {
-		url      string
+		link     string
 		expected string
 	}{
 		{"https://boardgamearena.com/tournament?id=423761", "423761"},
 		{"https://boardgamearena.com/tournament?id=423761&token=xyz", "423761"},
-		{"", ""},
-		{"invalid-url", ""},
-		{"https://boardgamearena.com/tournament", ""},
+		{"", "-"},
+		{"invalid-url", "-"},
+		{"https://boardgamearena.com/tournament", "-"},
 	}
 
 	for _, tc := range testCases {
-		result := model.extractTournamentID(tc.url)
-		if result != tc.expected {
-			t.Errorf("Expected tournament ID '%s' for URL '%s', got '%s'", tc.expected, tc.url, result)
+		if got := tournamentIDLabel(tc.link); got != tc.expected {
+			t.Errorf("Expected tournament ID %q for link %q, got %q", tc.expected, tc.link, got)
 		}
 	}
 }
 
 func TestFixtureModel_GetCurrentRound(t *testing.T) {
+	freezeOffSeason(t)
+
 	division := &fixtures.Division{
 		Name: "Elite",
 		Rounds: []*fixtures.Round{
@@ -365,6 +625,8 @@ func TestFixtureModel_GetCurrentRound(t *testing.T) {
 }
 
 func TestFixtureModel_TableFormat_RealData(t *testing.T) {
+	freezeOffSeason(t)
+
 	// Test with real fixture file to verify table formatting
 	filename := "../../data/Liga Argentina - 1° Temporada - E-Fixture.csv"
 
@@ -448,6 +710,8 @@ func TestFixtureModel_Update_VimNavigation_Left(t *testing.T) {
 }
 
 func TestFixtureModel_Update_VimNavigation_Right(t *testing.T) {
+	freezeOffSeason(t)
+
 	division := &fixtures.Division{
 		Name: "Elite",
 		Rounds: []*fixtures.Round{
@@ -517,6 +781,8 @@ func TestFixtureModel_Update_PageNavigation_Up(t *testing.T) {
 }
 
 func TestFixtureModel_Update_PageNavigation_Down(t *testing.T) {
+	freezeOffSeason(t)
+
 	division := &fixtures.Division{
 		Name: "Elite",
 		Rounds: []*fixtures.Round{
@@ -862,6 +1128,8 @@ func TestFixtureModel_Update_EnterCopyLink(t *testing.T) {
 		},
 	}
 
+	stubClipboard(t)
+
 	model := NewFixtureModel(division)
 	model.selectedMatch = 0 // Select first match
 
@@ -887,6 +1155,64 @@ func TestFixtureModel_Update_EnterCopyLink(t *testing.T) {
 	}
 }
 
+// stubClipboard replaces the system clipboard for the test, returning everything copied
+func stubClipboard(t *testing.T) *[]string {
+	t.Helper()
+
+	var copied []string
+	original := clipboardWriteAll
+	clipboardWriteAll = func(text string) error {
+		copied = append(copied, text)
+		return nil
+	}
+	t.Cleanup(func() { clipboardWriteAll = original })
+
+	return &copied
+}
+
+func TestFixtureModel_RecopyLastLink(t *testing.T) {
+	copied := stubClipboard(t)
+
+	division := &fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, Matches: []*fixtures.Match{
+				{ID: 3, HomePlayer: "herchu", AwayPlayer: "webbi"},
+				{ID: 4, HomePlayer: "Lord Trooper", AwayPlayer: "alehrosario"},
+			}},
+		},
+	}
+	model := NewFixtureModel(division)
+
+	_, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("Y")})
+	if !strings.Contains(model.statusMessage, "No link") || len(*copied) != 0 {
+		t.Fatalf("Expected nothing to re-copy yet, got %q", model.statusMessage)
+	}
+
+	link := "https://boardgamearena.com/tournament?id=423762"
+	_, _ = model.Update(tournamentCreatedMsg{
+		success:      true,
+		tournamentID: 423762,
+		link:         link,
+		matchID:      3,
+		roundNum:     0,
+	})
+
+	// Re-copying ignores the selection, even on a match without link
+	model.selectedMatch = 1
+	*copied = nil
+
+	_, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("Y")})
+
+	if model.statusMessage != "Re-copied last link" {
+		t.Errorf("Expected re-copy status, got %q", model.statusMessage)
+	}
+
+	if len(*copied) != 1 || (*copied)[0] != link {
+		t.Errorf("Expected the created link to be copied again, got %v", *copied)
+	}
+}
+
 func TestFixtureModel_Update_EnterCreateTournament(t *testing.T) {
 	division := &fixtures.Division{
 		Name: "Elite",
@@ -956,7 +1282,7 @@ func TestFixtureModel_CreateTournament_Integration(t *testing.T) {
 	model.SetBGAClient(mockClient)
 
 	// Login the mock client
-	err := mockClient.Login()
+	err := mockClient.Login(context.Background())
 	if err != nil {
 		t.Fatalf("Failed to login mock client: %v", err)
 	}
@@ -1054,7 +1380,7 @@ func TestFixtureModel_CreateTournament_Integration(t *testing.T) {
 			}
 
 			// Execute final async tournament creation
-			finalAsyncMsg := cmd()
+			finalAsyncMsg := runCmd(cmd)
 			tournamentMsg, ok := finalAsyncMsg.(tournamentCreatedMsg)
 			if !ok {
 				t.Fatalf("Expected tournamentCreatedMsg from final async creation, got %T", finalAsyncMsg)
@@ -2053,3 +2379,700 @@ func TestFixtureModel_View_CompleteTableStructureDemo(t *testing.T) {
 		t.Error("Expected table to show circle for unplayed matches")
 	}
 }
+
+func TestFixtureModel_Update_EscCancelsInFlightCreation(t *testing.T) {
+	division := &fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, Matches: []*fixtures.Match{{ID: 1, HomePlayer: "herchu", AwayPlayer: "webbi"}}},
+		},
+	}
+	model := NewFixtureModel(division)
+
+	mockClient := bga.NewMockClient("testuser", "testpass")
+	if err := mockClient.Login(context.Background()); err != nil {
+		t.Fatalf("Failed to login mock client: %v", err)
+	}
+	mockClient.SetLatency(200 * time.Millisecond)
+	model.SetBGAClient(mockClient)
+
+	_, createCmd := model.Update(createTournamentMsg{homePlayer: "herchu", awayPlayer: "webbi", matchID: 1})
+	if createCmd == nil {
+		t.Fatal("Expected a creation command")
+	}
+
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if cmd != nil {
+		t.Fatal("Expected ESC to cancel the creation instead of leaving the fixture")
+	}
+
+	msg, ok := runCmd(createCmd).(tournamentCreatedMsg)
+	if !ok {
+		t.Fatal("Expected tournamentCreatedMsg from the creation command")
+	}
+	if msg.success {
+		t.Fatal("Expected canceled creation to fail")
+	}
+
+	_, _ = model.Update(msg)
+	if model.cancelCreate != nil {
+		t.Error("Expected cancel func to be cleared once creation finished")
+	}
+	if len(mockClient.GetTournaments()) != 0 {
+		t.Error("Expected no tournament to be created after cancellation")
+	}
+
+	_, cmd = model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if cmd == nil {
+		t.Error("Expected ESC to go back once no creation is in flight")
+	}
+}
+
+func TestFixtureModel_TournamentCreated_RejectsMismatchedLink(t *testing.T) {
+	division := &fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, Matches: []*fixtures.Match{{ID: 3, HomePlayer: "herchu", AwayPlayer: "webbi"}}},
+		},
+	}
+	model := NewFixtureModel(division)
+
+	_, _ = model.Update(tournamentCreatedMsg{
+		success:      true,
+		tournamentID: 423762,
+		link:         "https://boardgamearena.com/tournament?id=423999",
+		matchID:      3,
+		roundNum:     0,
+	})
+
+	if link := division.Rounds[0].Matches[0].BGALink; link != "" {
+		t.Errorf("Expected mismatched link not to be stored, got %q", link)
+	}
+
+	if !strings.Contains(model.statusMessage, "423999") || !strings.Contains(model.statusMessage, "423762") {
+		t.Errorf("Expected status to report both IDs, got %q", model.statusMessage)
+	}
+}
+
+func TestFixtureModel_TournamentCreated_SavesLinkToFile(t *testing.T) {
+	data := "Duelo,Fecha 1,,,,11/08 - 17/08,Detalles,,¿Se jugó?,¿Ganó Local?,¿Ganó Visita?,,,\n" +
+		"3,herchu,0,0,webbi,-,,,0,0,0,,,\n"
+
+	filename := filepath.Join(t.TempDir(), "Liga Argentina - 1° Temporada - E-Fixture.csv")
+	if err := os.WriteFile(filename, []byte(data), 0o600); err != nil {
+		t.Fatalf("Failed to write fixture: %v", err)
+	}
+
+	division, err := fixtures.ParseFixtureFile(filename)
+	if err != nil {
+		t.Fatalf("Failed to parse fixture: %v", err)
+	}
+	model := NewFixtureModel(division)
+	model.SetBGAClient(bga.NewMockClient("testuser", "testpass"))
+
+	link := "https://boardgamearena.com/tournament?id=423762"
+	_, _ = model.Update(tournamentCreatedMsg{
+		success:      true,
+		tournamentID: 423762,
+		link:         link,
+		matchID:      3,
+		roundNum:     0,
+	})
+
+	if strings.Contains(model.statusMessage, "not saved") {
+		t.Fatalf("Expected the link to be saved, got %q", model.statusMessage)
+	}
+
+	reloaded, err := fixtures.ParseFixtureFile(filename)
+	if err != nil {
+		t.Fatalf("Failed to parse saved fixture: %v", err)
+	}
+
+	if got := reloaded.Rounds[0].Matches[0].BGALink; got != link {
+		t.Errorf("Expected the link to be written to the fixture file, got %q", got)
+	}
+}
+
+func TestValidateTournamentLink(t *testing.T) {
+	testCases := []struct {
+		name    string
+		link    string
+		id      int
+		wantErr bool
+	}{
+		{"matching", "https://boardgamearena.com/tournament?id=423762", 423762, false},
+		{"matching with extra params", "https://boardgamearena.com/tournament?id=423762&tab=games", 423762, false},
+		{"mismatched", "https://boardgamearena.com/tournament?id=423763", 423762, true},
+		{"unparseable", "https://boardgamearena.com/tournament", 423762, true},
+		{"empty", "", 423762, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateTournamentLink(tc.link, tc.id)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("validateTournamentLink(%q, %d) error = %v, wantErr %v", tc.link, tc.id, err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestFixtureModel_CreateLaunchAndInvite(t *testing.T) {
+	division := &fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, Matches: []*fixtures.Match{{ID: 15, HomePlayer: "herchu", AwayPlayer: "Lord Trooper"}}},
+		},
+	}
+	model := NewFixtureModel(division)
+
+	mockClient := bga.NewMockClient("testuser", "testpass")
+	if err := mockClient.Login(context.Background()); err != nil {
+		t.Fatalf("Failed to login mock client: %v", err)
+	}
+	model.SetBGAClient(mockClient)
+
+	_, cmd := model.Update(createTournamentMsg{homePlayer: "herchu", awayPlayer: "Lord Trooper", matchID: 15})
+	created, ok := runCmd(cmd).(tournamentCreatedMsg)
+	if !ok || !created.success {
+		t.Fatalf("Expected successful tournamentCreatedMsg, got %+v", created)
+	}
+
+	_, cmd = model.Update(created)
+	if !strings.Contains(model.statusMessage, "Launching") {
+		t.Errorf("Expected launch status, got %q", model.statusMessage)
+	}
+
+	launched, ok := cmd().(tournamentLaunchedMsg)
+	if !ok || launched.err != nil {
+		t.Fatalf("Expected successful tournamentLaunchedMsg, got %+v", launched)
+	}
+
+	_, cmd = model.Update(launched)
+	if !strings.Contains(model.statusMessage, "inviting herchu and Lord Trooper") {
+		t.Errorf("Expected invite status, got %q", model.statusMessage)
+	}
+
+	invited, ok := cmd().(playersInvitedMsg)
+	if !ok || invited.err != nil {
+		t.Fatalf("Expected successful playersInvitedMsg, got %+v", invited)
+	}
+
+	_, _ = model.Update(invited)
+	if !strings.Contains(model.statusMessage, "both players invited") {
+		t.Errorf("Expected final status, got %q", model.statusMessage)
+	}
+
+	status, err := mockClient.GetTournamentStatus(context.Background(), created.tournamentID)
+	if err != nil {
+		t.Fatalf("Failed to get tournament status: %v", err)
+	}
+	if status.Status != "open" {
+		t.Errorf("Expected tournament to be open, got %q", status.Status)
+	}
+}
+
+func TestFixtureModel_TournamentLaunched_ReportsFailure(t *testing.T) {
+	model := NewFixtureModel(&fixtures.Division{Name: "Elite"})
+	model.SetBGAClient(bga.NewMockClient("testuser", "testpass"))
+
+	_, _ = model.Update(tournamentLaunchedMsg{err: errors.New("tournament with ID 1 not found"), tournamentID: 1})
+	if !strings.Contains(model.statusMessage, "launch failed") {
+		t.Errorf("Expected launch failure status, got %q", model.statusMessage)
+	}
+
+	_, _ = model.Update(playersInvitedMsg{err: errors.New("boom"), failedPlayer: "webbi", tournamentID: 1})
+	if !strings.Contains(model.statusMessage, "inviting webbi failed") {
+		t.Errorf("Expected invite failure status, got %q", model.statusMessage)
+	}
+}
+
+func TestFixtureModel_View_ShowsScheduleStates(t *testing.T) {
+	division := &fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, DateRange: "15/03 - 22/03", Matches: []*fixtures.Match{
+				{ID: 1, HomePlayer: "herchu", AwayPlayer: "webbi"},
+				{ID: 2, HomePlayer: "Lord Trooper", AwayPlayer: "alehrosario", DateTime: "18/03 21:00"},
+				{
+					ID: 3, HomePlayer: "Academia47", AwayPlayer: "bignacho610", DateTime: "19/03 20:00",
+					BGALink: "https://boardgamearena.com/tournament?id=423762",
+				},
+			}},
+		},
+	}
+	model := NewFixtureModel(division)
+	view := model.View()
+
+	if !strings.Contains(view, "STATUS") {
+		t.Error("Expected STATUS column header")
+	}
+
+	lines := strings.Split(view, "\n")
+	expected := map[string]string{
+		"webbi":       "unscheduled",
+		"alehrosario": "needs tournament",
+		"bignacho610": "created",
+	}
+	for player, state := range expected {
+		found := false
+		for _, line := range lines {
+			if strings.Contains(line, player) {
+				found = true
+				if !strings.Contains(line, state) {
+					t.Errorf("Expected row for %s to show %q, got %q", player, state, line)
+				}
+			}
+		}
+		if !found {
+			t.Errorf("Expected a row for %s", player)
+		}
+	}
+}
+
+func TestFixtureModel_StatusClearDelay_Custom(t *testing.T) {
+	model := NewFixtureModel(&fixtures.Division{Name: "Elite"})
+	if model.statusClearDelay != DefaultStatusClearDelay {
+		t.Errorf("Expected default delay %v, got %v", DefaultStatusClearDelay, model.statusClearDelay)
+	}
+
+	model.SetStatusClearDelay(20 * time.Millisecond)
+
+	_, cmd := model.Update(DateTimePickerCanceledMsg{})
+	if cmd == nil {
+		t.Fatal("Expected a clear command after setting a status message")
+	}
+
+	start := time.Now()
+	msg := cmd()
+	elapsed := time.Since(start)
+
+	if _, ok := msg.(clearStatusMsg); !ok {
+		t.Fatalf("Expected clearStatusMsg, got %T", msg)
+	}
+	if elapsed >= time.Second {
+		t.Errorf("Expected the clear tick to use the custom delay, took %v", elapsed)
+	}
+
+	_, _ = model.Update(msg)
+	if model.statusMessage != "" {
+		t.Errorf("Expected status to be cleared, got %q", model.statusMessage)
+	}
+}
+
+func TestFixtureModel_SetStatusClearDelay_IgnoresNonPositive(t *testing.T) {
+	model := NewFixtureModel(&fixtures.Division{Name: "Elite"})
+	model.SetStatusClearDelay(0)
+
+	if model.statusClearDelay != DefaultStatusClearDelay {
+		t.Errorf("Expected default delay to be kept, got %v", model.statusClearDelay)
+	}
+}
+
+func TestFixtureModel_ToggleTimeFormat(t *testing.T) {
+	model := NewFixtureModel(&fixtures.Division{Name: "Elite"})
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'T'}})
+	if !model.use24Hour {
+		t.Fatal("Expected 'T' to switch to 24-hour display")
+	}
+	if !strings.Contains(model.statusMessage, "24-hour") {
+		t.Errorf("Expected status to mention 24-hour format, got: %s", model.statusMessage)
+	}
+
+	model.Update(DateTimeSelectedMsg{
+		DateTime:    time.Date(2025, 3, 15, 14, 30, 0, 0, time.Local),
+		HomePlayer:  "herchu",
+		AwayPlayer:  "Lord Trooper",
+		Division:    "Elite",
+		RoundNumber: 1,
+		MatchNumber: 15,
+		MatchID:     15,
+	})
+	if model.confirmationModel == nil || !model.confirmationModel.use24Hour {
+		t.Error("Expected confirmation screen to inherit 24-hour display")
+	}
+
+	model.Update(TournamentConfirmationCanceledMsg{})
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'T'}})
+	if model.use24Hour {
+		t.Error("Expected second 'T' to switch back to 12-hour display")
+	}
+}
+
+func TestFixtureModel_JumpToSoonest(t *testing.T) {
+	division := &fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, Matches: []*fixtures.Match{
+				{ID: 1, HomePlayer: "herchu", AwayPlayer: "webbi", DateTime: "10/08 - 21:00", Played: true},
+				{ID: 2, HomePlayer: "Lord Trooper", AwayPlayer: "alehrosario", DateTime: "-"},
+			}},
+			{Number: 2, Matches: []*fixtures.Match{
+				{ID: 3, HomePlayer: "herchu", AwayPlayer: "Lord Trooper", DateTime: "20/08 - 21:00"},
+				{ID: 4, HomePlayer: "alehrosario", AwayPlayer: "webbi", DateTime: "14/08 - 22:30",
+					BGALink: "https://boardgamearena.com/tournament?id=423761"},
+			}},
+			{Number: 3, Matches: []*fixtures.Match{
+				{ID: 5, HomePlayer: "alehrosario", AwayPlayer: "herchu", DateTime: "25/08 - 18:00"},
+				{ID: 6, HomePlayer: "webbi", AwayPlayer: "Lord Trooper", DateTime: "15/08 - 19:00"},
+			}},
+		},
+	}
+
+	model := NewFixtureModel(division)
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'u'}})
+
+	if model.currentRound != 2 || model.selectedMatch != 1 {
+		t.Errorf("Expected earliest scheduled match (round 3, match 6) selected, got round %d match %d",
+			model.currentRound+1, model.selectedMatch)
+	}
+
+	if !strings.Contains(model.statusMessage, "webbi vs Lord Trooper") {
+		t.Errorf("Expected status to name the selected match, got: %s", model.statusMessage)
+	}
+}
+
+func TestFixtureModel_JumpToSoonest_FallsBackToFirstUnscheduled(t *testing.T) {
+	division := &fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, Matches: []*fixtures.Match{
+				{ID: 1, HomePlayer: "herchu", AwayPlayer: "webbi", Played: true},
+				{ID: 4, HomePlayer: "alehrosario", AwayPlayer: "BYE"},
+			}},
+			{Number: 2, Matches: []*fixtures.Match{
+				{ID: 2, HomePlayer: "herchu", AwayPlayer: "Lord Trooper", DateTime: "-",
+					BGALink: "https://boardgamearena.com/tournament?id=423761"},
+				{ID: 3, HomePlayer: "alehrosario", AwayPlayer: "webbi", DateTime: "-"},
+			}},
+		},
+	}
+
+	model := NewFixtureModel(division)
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'u'}})
+
+	if model.currentRound != 1 || model.selectedMatch != 1 {
+		t.Errorf("Expected first link-less unplayed match past the bye selected, got round %d match %d",
+			model.currentRound+1, model.selectedMatch)
+	}
+
+	model.division.Rounds[1].Matches[1].Played = true
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'u'}})
+
+	if model.statusMessage != "No matches need a tournament" {
+		t.Errorf("Expected nothing-to-do status, got: %s", model.statusMessage)
+	}
+}
+
+func TestFixtureModel_View_ISODates(t *testing.T) {
+	division := &fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, DateRange: "11/08 - 17/08", Matches: []*fixtures.Match{
+				{ID: 1, HomePlayer: "herchu", AwayPlayer: "webbi", DateTime: "12/08 - 09:30"},
+				{ID: 2, HomePlayer: "Lord Trooper", AwayPlayer: "alehrosario", DateTime: "a confirmar"},
+			}},
+		},
+	}
+
+	model := NewFixtureModel(division)
+	iso := fmt.Sprintf("%d-08-12 09:30", time.Now().Year())
+
+	view := model.View()
+	if !strings.Contains(view, "12/08 - 09:30") || strings.Contains(view, iso) {
+		t.Errorf("Expected the fixture date verbatim with ISO dates off, got: %s", view)
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'D'}})
+
+	view = model.View()
+	if !strings.Contains(view, iso) {
+		t.Errorf("Expected ISO date %q with ISO dates on, got: %s", iso, view)
+	}
+	if !strings.Contains(view, "a confirmar") {
+		t.Errorf("Expected unparseable dates to stay verbatim, got: %s", view)
+	}
+}
+
+func TestCreationErrorText_FriendlyBGAErrors(t *testing.T) {
+	tests := []struct {
+		err      error
+		expected string
+	}{
+		{fmt.Errorf("create: %w", bga.ErrNotAuthenticated), "not logged in to BGA"},
+		{fmt.Errorf("status: %w", bga.ErrTournamentNotFound), "no longer exists on BGA"},
+		{&bga.ValidationError{Field: "username", Message: "player username is required"}, "player username is required"},
+		{errors.New("connection reset"), "connection reset"},
+	}
+
+	for _, tt := range tests {
+		if text := creationErrorText(tt.err); !strings.Contains(text, tt.expected) {
+			t.Errorf("Expected %q in error text, got %q", tt.expected, text)
+		}
+	}
+
+	if text := loginErrorText(fmt.Errorf("login: %w", bga.ErrInvalidCredentials)); !strings.Contains(text, "BGA_USER") {
+		t.Errorf("Expected invalid credentials to point at BGA_USER, got %q", text)
+	}
+}
+
+func TestFixtureModel_WindowSize_TableFitsNarrowTerminal(t *testing.T) {
+	division := &fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, DateRange: "01/09 - 07/09", Matches: []*fixtures.Match{
+				{
+					ID: 1, HomePlayer: "longplayername", AwayPlayer: "anotherplayer", Played: true,
+					HomeScore: 2, AwayScore: 1, DateTime: "03/09 21:00",
+					BGALink: "https://boardgamearena.com/tournament?id=423762",
+				},
+				{ID: 2, HomePlayer: "carol", AwayPlayer: "dave", DateTime: "05/09 18:30"},
+			}},
+		},
+	}
+
+	model := NewFixtureModel(division)
+
+	_, _ = model.Update(tea.WindowSizeMsg{Width: 200, Height: 40})
+	if view := model.View(); !strings.Contains(view, "TOURNAMENT_ID") || !strings.Contains(view, "DATE") {
+		t.Errorf("Expected every column on a wide terminal, got:\n%s", view)
+	}
+
+	_, _ = model.Update(tea.WindowSizeMsg{Width: 72, Height: 40})
+	view := model.View()
+
+	for _, line := range strings.Split(view, "\n") {
+		if width := lipgloss.Width(line); width > 72 {
+			t.Errorf("Expected every line to fit in 72 columns, got %d: %q", width, line)
+		}
+	}
+
+	if strings.Contains(view, "TOURNAMENT_ID") {
+		t.Errorf("Expected TOURNAMENT_ID to be dropped on a narrow terminal, got:\n%s", view)
+	}
+	if !strings.Contains(view, "longplayername") || !strings.Contains(view, "STATUS") {
+		t.Errorf("Expected players and status to stay, got:\n%s", view)
+	}
+}
+
+func stubBrowser(t *testing.T) *[]string {
+	t.Helper()
+
+	var opened []string
+	original := browserOpen
+	browserOpen = func(url string) error {
+		opened = append(opened, url)
+		return nil
+	}
+	t.Cleanup(func() { browserOpen = original })
+
+	return &opened
+}
+
+func TestFixtureModel_OpenLink(t *testing.T) {
+	opened := stubBrowser(t)
+	copied := stubClipboard(t)
+
+	link := "https://boardgamearena.com/tournament?id=423762"
+	division := &fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, Matches: []*fixtures.Match{
+				{ID: 3, HomePlayer: "herchu", AwayPlayer: "webbi"},
+				{ID: 4, HomePlayer: "Lord Trooper", AwayPlayer: "alehrosario", BGALink: link},
+			}},
+		},
+	}
+	model := NewFixtureModel(division)
+
+	_, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("o")})
+	if len(*opened) != 0 || !strings.Contains(model.statusMessage, "No tournament link") {
+		t.Fatalf("Expected nothing to open for a match without link, got %v (%q)", *opened, model.statusMessage)
+	}
+
+	model.selectedMatch = 1
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("o")})
+
+	if len(*opened) != 1 || (*opened)[0] != link {
+		t.Errorf("Expected the selected match's link to be opened, got %v", *opened)
+	}
+	if model.statusMessage != "Opened tournament in the browser" {
+		t.Errorf("Expected open status, got %q", model.statusMessage)
+	}
+	if cmd == nil {
+		t.Error("Expected the status to be cleared later")
+	}
+	if len(*copied) != 0 {
+		t.Errorf("Expected opening not to touch the clipboard, got %v", *copied)
+	}
+}
+
+func TestFixtureModel_OpenLink_Failure(t *testing.T) {
+	original := browserOpen
+	browserOpen = func(string) error { return errors.New("no browser") }
+	t.Cleanup(func() { browserOpen = original })
+
+	division := &fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, Matches: []*fixtures.Match{
+				{ID: 3, HomePlayer: "herchu", AwayPlayer: "webbi", BGALink: "https://boardgamearena.com/tournament?id=1"},
+			}},
+		},
+	}
+	model := NewFixtureModel(division)
+
+	_, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("o")})
+	if model.statusMessage != "Failed to open the link in the browser" {
+		t.Errorf("Expected failure status, got %q", model.statusMessage)
+	}
+}
+
+func TestFixtureModel_ToggleHelpFooter(t *testing.T) {
+	division := &fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, DateRange: "11/08 - 17/08", Matches: []*fixtures.Match{
+				{ID: 1, HomePlayer: "herchu", AwayPlayer: "webbi"},
+			}},
+		},
+	}
+	model := NewFixtureModel(division)
+
+	expanded := model.View()
+	expandedLines := strings.Count(expanded, "\n")
+
+	_, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("?")})
+	collapsed := model.View()
+
+	if strings.Count(collapsed, "\n") >= expandedLines {
+		t.Errorf("Expected fewer lines once collapsed, got %d vs %d", strings.Count(collapsed, "\n"), expandedLines)
+	}
+	if !strings.Contains(collapsed, "Press ? for help") || strings.Contains(collapsed, "navigate rounds") {
+		t.Errorf("Expected only the help hint in the collapsed footer, got:\n%s", collapsed)
+	}
+
+	_, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("?")})
+	if model.View() != expanded {
+		t.Errorf("Expected ? to restore the full footer, got:\n%s", model.View())
+	}
+}
+
+func TestFixtureModel_SpinnerShownWhileCreating(t *testing.T) {
+	division := &fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, Matches: []*fixtures.Match{{ID: 15, HomePlayer: "herchu", AwayPlayer: "Lord Trooper"}}},
+		},
+	}
+	model := NewFixtureModel(division)
+
+	mockClient := bga.NewMockClient("testuser", "testpass")
+	if err := mockClient.Login(context.Background()); err != nil {
+		t.Fatalf("Failed to login mock client: %v", err)
+	}
+	model.SetBGAClient(mockClient)
+	model.statusMessage = "Creating tournament for herchu vs Lord Trooper..."
+
+	_, cmd := model.Update(createTournamentMsgWithDateTime{
+		homePlayer: "herchu",
+		awayPlayer: "Lord Trooper",
+		dateTime:   time.Date(2025, 9, 20, 21, 0, 0, 0, time.UTC),
+		matchID:    15,
+		roundNum:   1,
+	})
+	if cmd == nil {
+		t.Fatal("Expected a creation command")
+	}
+	if !model.spinnerActive {
+		t.Fatal("Expected the spinner to start with the creation")
+	}
+	if !strings.Contains(model.View(), model.spinner.View()+" "+model.statusMessage) {
+		t.Errorf("Expected the spinner frame next to the status message, got:\n%s", model.View())
+	}
+
+	tick := model.spinner.Tick()
+	if _, next := model.Update(tick); next == nil {
+		t.Error("Expected the spinner to keep ticking while the creation is pending")
+	}
+
+	model.Update(runCmd(cmd))
+	if model.spinnerActive {
+		t.Error("Expected the spinner to stop once the tournament was created")
+	}
+	if _, next := model.Update(model.spinner.Tick()); next != nil {
+		t.Error("Expected spinner ticks to stop after the creation finished")
+	}
+}
+
+func TestNewFixtureModel_OpensOnTodaysRound(t *testing.T) {
+	newDivision := func() *fixtures.Division {
+		return &fixtures.Division{
+			Name: "Elite",
+			Rounds: []*fixtures.Round{
+				{Number: 1, DateRange: "11/08 - 17/08"},
+				{Number: 2, DateRange: "18/08 - 24/08"},
+				{Number: 3, DateRange: "25/08 - 31/08"},
+			},
+		}
+	}
+
+	testCases := []struct {
+		today    time.Time
+		name     string
+		expected int
+	}{
+		{time.Date(2025, 8, 20, 12, 0, 0, 0, time.Local), "inside a round", 1},
+		{time.Date(2025, 8, 1, 12, 0, 0, 0, time.Local), "before the first round", 0},
+		{time.Date(2025, 9, 15, 12, 0, 0, 0, time.Local), "after the last round", 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			freezeClock(t, tc.today)
+
+			if got := NewFixtureModel(newDivision()).currentRound; got != tc.expected {
+				t.Errorf("Expected round index %d, got %d", tc.expected, got)
+			}
+		})
+	}
+
+	freezeClock(t, time.Date(2025, 8, 28, 12, 0, 0, 0, time.Local))
+	model := NewFixtureModel(newDivision())
+	model.SetAutoSelectRound(false)
+	if model.currentRound != 0 {
+		t.Errorf("Expected the first round with auto-selection off, got %d", model.currentRound)
+	}
+}
+
+func TestFixtureModel_JumpToNextRound(t *testing.T) {
+	freezeOffSeason(t)
+
+	model := NewFixtureModel(&fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, Matches: []*fixtures.Match{
+				{ID: 1, HomePlayer: "herchu", AwayPlayer: "webbi", BGALink: "https://boardgamearena.com/tournament?id=1"},
+			}},
+			{Number: 2, Matches: []*fixtures.Match{
+				{ID: 2, HomePlayer: "herchu", AwayPlayer: "alehrosario", Played: true},
+			}},
+			{Number: 3, Matches: []*fixtures.Match{
+				{ID: 3, HomePlayer: "webbi", AwayPlayer: "Lord Trooper"},
+				{ID: 4, HomePlayer: "herchu", AwayPlayer: "kuro"},
+			}},
+		},
+	})
+	model.selectedMatch = 0
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+
+	if model.currentRound != 2 || model.selectedMatch != 0 {
+		t.Errorf("Expected to jump to the first match of round 3, got round %d match %d",
+			model.currentRound, model.selectedMatch)
+	}
+	if model.statusMessage != "Next round to schedule: Round 3" {
+		t.Errorf("Expected the next round status, got %q", model.statusMessage)
+	}
+}
@@ -0,0 +1,81 @@
+package cli
+
+import (
+	"fmt"
+
+	"carca-cli/internal/fixtures"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// handleWalkoverKey handles 'w' key by asking which player of the selected match gets the walkover
+func (m *FixtureModel) handleWalkoverKey() (tea.Model, tea.Cmd) {
+	match := m.selectedFixtureMatch()
+	if match == nil {
+		return m, nil
+	}
+
+	switch {
+	case match.IsBye():
+		m.statusMessage = "No walkover to record for a bye"
+		return m, m.clearStatus()
+	case missingPlayerName(match.HomePlayer, match.AwayPlayer):
+		m.statusMessage = missingPlayerStatus
+		return m, m.clearStatus()
+	case match.Played:
+		m.statusMessage = "Match already played"
+		return m, m.clearStatus()
+	}
+
+	m.walkoverPrompt = true
+	m.statusMessage = fmt.Sprintf("Walkover for (h)ome %s or (a)way %s? Any other key cancels",
+		match.HomePlayer, match.AwayPlayer)
+
+	return m, nil
+}
+
+// handleWalkoverChoice resolves the walkover prompt with the pressed key
+func (m *FixtureModel) handleWalkoverChoice(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	m.walkoverPrompt = false
+
+	match := m.selectedFixtureMatch()
+	if match == nil {
+		return m, nil
+	}
+
+	var winner string
+	switch msg.String() {
+	case "h":
+		winner = match.HomePlayer
+	case "a":
+		winner = match.AwayPlayer
+	default:
+		m.statusMessage = "Walkover canceled"
+		return m, m.clearStatus()
+	}
+
+	if err := match.MarkWalkover(winner); err != nil {
+		m.statusMessage = fmt.Sprintf("Walkover failed: %v", err)
+		return m, m.clearStatus()
+	}
+
+	m.statusMessage = fmt.Sprintf("Walkover: %s wins Duelo %d", winner, match.ID)
+
+	if m.division.Filename != "" {
+		if err := fixtures.WriteDivisionFile(m.division, m.division.Filename); err != nil {
+			m.statusMessage += fmt.Sprintf(" (not saved: %v)", err)
+		}
+	}
+
+	return m, m.clearStatus()
+}
+
+// selectedFixtureMatch returns the match under the cursor, or nil when the round is empty
+func (m *FixtureModel) selectedFixtureMatch() *fixtures.Match {
+	currentRound := m.GetCurrentRound()
+	if currentRound == nil || m.selectedMatch >= len(currentRound.Matches) {
+		return nil
+	}
+
+	return currentRound.Matches[m.selectedMatch]
+}
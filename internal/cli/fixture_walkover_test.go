@@ -0,0 +1,127 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"carca-cli/internal/fixtures"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestFixtureModel_MarkWalkover(t *testing.T) {
+	data := "Duelo,Fecha 1,,,,11/08 - 17/08,Detalles,,¿Se jugó?,¿Ganó Local?,¿Ganó Visita?,,,\n" +
+		"1,herchu,2,1,Lord Trooper,12/08 - 09:30,https://boardgamearena.com/tournament?id=423761,,1,1,0,,,\n" +
+		"2,webbi,0,0,alehrosario,-,,,0,0,0,,,\n"
+
+	filename := filepath.Join(t.TempDir(), "Liga Argentina - 1° Temporada - E-Fixture.csv")
+	if err := os.WriteFile(filename, []byte(data), 0o600); err != nil {
+		t.Fatalf("Failed to write fixture: %v", err)
+	}
+
+	division, err := fixtures.ParseFixtureFile(filename)
+	if err != nil {
+		t.Fatalf("Failed to parse fixture: %v", err)
+	}
+
+	model := NewFixtureModel(division)
+	model.selectedMatch = 1
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'w'}})
+	if !model.walkoverPrompt || !strings.Contains(model.statusMessage, "(h)ome webbi") {
+		t.Fatalf("Expected a home/away prompt, got: %s", model.statusMessage)
+	}
+
+	// 'a' picks the away player instead of navigating
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
+
+	match := division.Rounds[0].Matches[1]
+	if !match.Played || !match.Walkover || !match.HomeForfeited() {
+		t.Errorf("Expected an away walkover, got %+v", match)
+	}
+
+	if model.bulk != nil {
+		t.Error("Expected the prompt answer not to trigger bulk creation")
+	}
+
+	if !strings.Contains(model.statusMessage, "alehrosario wins Duelo 2") {
+		t.Errorf("Expected walkover status, got: %s", model.statusMessage)
+	}
+
+	if form := strings.Join(fixtures.RecentForm(division, "alehrosario", 5), ""); form != "W" {
+		t.Errorf("Expected the walkover to count as a win in the standings, got %q", form)
+	}
+
+	reloaded, err := fixtures.ParseFixtureFile(filename)
+	if err != nil {
+		t.Fatalf("Failed to parse saved fixture: %v", err)
+	}
+
+	if saved := reloaded.Rounds[0].Matches[1]; !saved.Walkover {
+		t.Errorf("Expected the walkover written back to the CSV, got %+v", saved)
+	}
+}
+
+func TestFixtureModel_MarkWalkover_CanceledAndPlayed(t *testing.T) {
+	division := &fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, Matches: []*fixtures.Match{
+				{ID: 1, HomePlayer: "herchu", AwayPlayer: "webbi", Played: true, HomeScore: 2, AwayScore: 1},
+				{ID: 2, HomePlayer: "Lord Trooper", AwayPlayer: "alehrosario"},
+			}},
+		},
+	}
+
+	model := NewFixtureModel(division)
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'w'}})
+	if model.walkoverPrompt || model.statusMessage != "Match already played" {
+		t.Errorf("Expected played matches to be refused, got: %s", model.statusMessage)
+	}
+
+	model.selectedMatch = 1
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'w'}})
+	model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+	if model.walkoverPrompt || division.Rounds[0].Matches[1].Played {
+		t.Error("Expected esc to cancel the walkover without changing the match")
+	}
+
+	if model.statusMessage != "Walkover canceled" {
+		t.Errorf("Expected cancel status, got: %s", model.statusMessage)
+	}
+}
+
+func TestFixtureModel_MarkWalkover_RefusesByeAndMissingPlayer(t *testing.T) {
+	division := &fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, Matches: []*fixtures.Match{
+				{ID: 1, HomePlayer: "herchu", AwayPlayer: "BYE"},
+				{ID: 2, HomePlayer: " ", AwayPlayer: ""},
+			}},
+		},
+	}
+
+	model := NewFixtureModel(division)
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'w'}})
+	if model.walkoverPrompt || model.statusMessage != "No walkover to record for a bye" {
+		t.Errorf("Expected the bye to be refused, got: %s", model.statusMessage)
+	}
+
+	model.selectedMatch = 1
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'w'}})
+	if model.walkoverPrompt || model.statusMessage != missingPlayerStatus {
+		t.Errorf("Expected the match without player names to be refused, got: %s", model.statusMessage)
+	}
+
+	for _, match := range division.Rounds[0].Matches {
+		if match.Played || match.Walkover {
+			t.Errorf("Expected match %d left unplayed, got %+v", match.ID, match)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package cli
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// homeKey returns to the main menu from any screen, dropping the picker or confirmation on the way
+const homeKey = "H"
+
+// activeFixture returns the fixture model behind the current screen, nil when there is none
+func (m *AppModel) activeFixture() *FixtureModel {
+	switch {
+	case m.currentScreen == ScreenFixture:
+		return m.fixtureModel
+	case m.currentScreen == ScreenCreateTournament && m.createModel != nil:
+		return m.createModel.fixture
+	default:
+		return nil
+	}
+}
+
+// canGoHome reports whether the home key should unwind to the menu instead of being typed into a text field
+func (m *AppModel) canGoHome() bool {
+	if m.currentScreen == ScreenMenu {
+		return false
+	}
+
+	fixture := m.activeFixture()
+	if fixture == nil {
+		return true
+	}
+
+	// Pickers and prompts that capture input are dropped on the way home, but text fields keep the key
+	return !fixture.typingText()
+}
+
+// goHome unwinds to the main menu
+// An in-flight tournament creation is only canceled after the home key is pressed a second time
+func (m *AppModel) goHome() (tea.Model, tea.Cmd) {
+	fixture := m.activeFixture()
+	if fixture != nil && fixture.cancelCreate != nil {
+		if !m.homePending {
+			m.homePending = true
+			fixture.statusMessage = "A tournament creation is in progress, press H again to cancel it and go home"
+			return m, nil
+		}
+
+		fixture.cancelCreate()
+		if fixture.bulk != nil {
+			fixture.bulk.queue = nil
+		}
+	}
+
+	m.homePending = false
+	return m.Update(BackToMenuMsg{})
+}
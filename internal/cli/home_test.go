@@ -0,0 +1,94 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"carca-cli/internal/fixtures"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func pressHome(model *AppModel) {
+	_, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(homeKey)})
+}
+
+func TestAppModel_HomeKey_FromFixturePicker(t *testing.T) {
+	model := newFixtureAppModel()
+
+	_, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
+	if !model.fixtureModel.showDatePicker {
+		t.Fatal("Expected the datetime picker to open")
+	}
+
+	pressHome(model)
+
+	if model.currentScreen != ScreenMenu {
+		t.Errorf("Expected the home key to return to the menu, got screen %d", model.currentScreen)
+	}
+	if model.fixtureModel != nil || model.createModel != nil || model.divisionModel != nil || model.standingsModel != nil {
+		t.Error("Expected the screen models to be cleared")
+	}
+}
+
+func TestAppModel_HomeKey_FromCreateTournamentConfirmation(t *testing.T) {
+	model := NewAppModel()
+	model.currentScreen = ScreenCreateTournament
+	model.createModel = NewCreateTournamentModel(model.newFixtureModel(&fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, Matches: []*fixtures.Match{{ID: 1, HomePlayer: "herchu", AwayPlayer: "webbi"}}},
+		},
+	}))
+	model.createModel.fixture.showConfirmation = true
+	model.createModel.fixture.confirmationModel = NewTournamentConfirmationModel(
+		"herchu", "webbi", "Elite", 1, 1, 1, now())
+
+	pressHome(model)
+
+	if model.currentScreen != ScreenMenu || model.createModel != nil {
+		t.Errorf("Expected the confirmation to be dropped on the way to the menu, got screen %d", model.currentScreen)
+	}
+}
+
+func TestAppModel_HomeKey_TypedIntoSearch(t *testing.T) {
+	model := newFixtureAppModel()
+
+	_, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("/")})
+	pressHome(model)
+
+	if model.currentScreen != ScreenFixture {
+		t.Fatal("Expected the home key to be typed while searching")
+	}
+	if got := model.fixtureModel.searchInput.Value(); got != homeKey {
+		t.Errorf("Expected %q in the search input, got %q", homeKey, got)
+	}
+}
+
+func TestAppModel_HomeKey_GuardsInFlightCreation(t *testing.T) {
+	model := newFixtureAppModel()
+
+	canceled := 0
+	model.fixtureModel.cancelCreate = func() { canceled++ }
+
+	pressHome(model)
+	if model.currentScreen != ScreenFixture || canceled != 0 {
+		t.Fatal("Expected the first home key to keep the creation running")
+	}
+	if !strings.Contains(model.fixtureModel.statusMessage, "press H again") {
+		t.Errorf("Expected a warning about the creation in progress, got: %s", model.fixtureModel.statusMessage)
+	}
+
+	// Any other key drops the pending home request
+	_, _ = model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	pressHome(model)
+	if model.currentScreen != ScreenFixture || canceled != 0 {
+		t.Fatal("Expected the warning to be shown again after another key")
+	}
+
+	pressHome(model)
+	if model.currentScreen != ScreenMenu || canceled != 1 {
+		t.Errorf("Expected the second home key to cancel the creation and go home, got screen %d, %d cancels",
+			model.currentScreen, canceled)
+	}
+}
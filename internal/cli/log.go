@@ -0,0 +1,124 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// maxLogEntries bounds the in-session event log
+const maxLogEntries = 200
+
+// logPageSize is how many log entries the log panel shows at once
+const logPageSize = 15
+
+// LogEntry is a notable event recorded during the session
+type LogEntry struct {
+	Time    time.Time
+	Text    string
+	IsError bool
+}
+
+// appendLog records an event, dropping the oldest entries beyond maxLogEntries
+func (m *AppModel) appendLog(text string, isError bool) {
+	m.log = append(m.log, LogEntry{Time: now(), Text: text, IsError: isError})
+	if len(m.log) > maxLogEntries {
+		m.log = m.log[len(m.log)-maxLogEntries:]
+	}
+}
+
+// recordEvent adds a log entry for messages that describe notable outcomes
+func (m *AppModel) recordEvent(msg tea.Msg) {
+	switch msg := msg.(type) {
+	case tournamentCreatedMsg:
+		if msg.success {
+			m.appendLog(fmt.Sprintf("Tournament %d created for match %d: %s", msg.tournamentID, msg.matchID, msg.link), false)
+		} else {
+			m.appendLog(fmt.Sprintf("Tournament creation failed for match %d: %s", msg.matchID, msg.error), true)
+		}
+	case tournamentLaunchedMsg:
+		if msg.err != nil {
+			m.appendLog(fmt.Sprintf("Launch of tournament %d failed: %v", msg.tournamentID, msg.err), true)
+		} else {
+			m.appendLog(fmt.Sprintf("Tournament %d launched", msg.tournamentID), false)
+		}
+	case playersInvitedMsg:
+		if msg.err != nil {
+			m.appendLog(fmt.Sprintf("Inviting %s to tournament %d failed: %v", msg.failedPlayer, msg.tournamentID, msg.err), true)
+		} else {
+			m.appendLog(fmt.Sprintf("Both players invited to tournament %d", msg.tournamentID), false)
+		}
+	}
+}
+
+// canOpenLog reports whether the current screen lets the log key through, i.e. no form is capturing input
+func (m *AppModel) canOpenLog() bool {
+	if m.currentScreen == ScreenFixture && m.fixtureModel != nil {
+		return !m.fixtureModel.capturingInput()
+	}
+	if m.currentScreen == ScreenCreateTournament && m.createModel != nil {
+		return !m.createModel.scheduling()
+	}
+	return true
+}
+
+// updateLog handles keys while the log panel is open
+func (m *AppModel) updateLog(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	maxScroll := max(len(m.log)-logPageSize, 0)
+
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+	case "esc", "q", "L":
+		m.showLog = false
+	case "up", "k":
+		m.logScroll = max(m.logScroll-1, 0)
+	case "down", "j":
+		m.logScroll = min(m.logScroll+1, maxScroll)
+	case "pgup":
+		m.logScroll = max(m.logScroll-logPageSize, 0)
+	case "pgdown":
+		m.logScroll = min(m.logScroll+logPageSize, maxScroll)
+	}
+
+	return m, nil
+}
+
+// openLog shows the log panel scrolled to the most recent entries
+func (m *AppModel) openLog() {
+	m.showLog = true
+	m.logScroll = max(len(m.log)-logPageSize, 0)
+}
+
+// renderLog renders the scrollable log panel
+func (m *AppModel) renderLog() string {
+	title := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#7D56F4")).
+		Bold(true).
+		Render("Session Log")
+
+	s := fmt.Sprintf("\n%s\n\n", title)
+
+	if len(m.log) == 0 {
+		s += "Nothing has happened yet.\n"
+	}
+
+	end := min(m.logScroll+logPageSize, len(m.log))
+	for _, entry := range m.log[m.logScroll:end] {
+		line := fmt.Sprintf("[%s] %s", entry.Time.Format("15:04:05"), entry.Text)
+		if entry.IsError {
+			line = lipgloss.NewStyle().Foreground(lipgloss.Color("#FF6B6B")).Render(line)
+		}
+		s += line + "\n"
+	}
+
+	if len(m.log) > logPageSize {
+		s += fmt.Sprintf("\nShowing %d-%d of %d", m.logScroll+1, end, len(m.log))
+	}
+
+	s += "\n\nPress ↑/↓ or j/k to scroll, esc/q/L to close.\n"
+
+	return s
+}
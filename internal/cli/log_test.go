@@ -0,0 +1,121 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"carca-cli/internal/fixtures"
+)
+
+func newFixtureAppModel() *AppModel {
+	model := NewAppModel()
+	model.currentScreen = ScreenFixture
+	model.fixtureModel = NewFixtureModel(&fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, Matches: []*fixtures.Match{{ID: 1, HomePlayer: "herchu", AwayPlayer: "webbi"}}},
+		},
+	})
+
+	return model
+}
+
+func TestAppModel_Log_RecordsCreationAndFailures(t *testing.T) {
+	model := newFixtureAppModel()
+
+	_, _ = model.Update(tournamentCreatedMsg{
+		success:      true,
+		tournamentID: 423762,
+		link:         "https://boardgamearena.com/tournament?id=423762",
+		matchID:      1,
+	})
+	_, _ = model.Update(tournamentLaunchedMsg{err: errors.New("tournament already started"), tournamentID: 423762})
+
+	if len(model.log) != 2 {
+		t.Fatalf("Expected 2 log entries, got %d", len(model.log))
+	}
+
+	if model.log[0].IsError || !strings.Contains(model.log[0].Text, "Tournament 423762 created") {
+		t.Errorf("Expected creation entry, got %+v", model.log[0])
+	}
+
+	if !model.log[1].IsError || !strings.Contains(model.log[1].Text, "already started") {
+		t.Errorf("Expected failure entry, got %+v", model.log[1])
+	}
+
+	_, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'L'}})
+	if !model.showLog {
+		t.Fatal("Expected L to open the log panel")
+	}
+
+	view := model.View()
+	if !strings.Contains(view, "Session Log") || !strings.Contains(view, "Tournament 423762 created") {
+		t.Errorf("Expected log panel to list the entries, got:\n%s", view)
+	}
+
+	_, _ = model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if model.showLog {
+		t.Error("Expected esc to close the log panel")
+	}
+	if model.currentScreen != ScreenFixture {
+		t.Error("Expected closing the log to keep the current screen")
+	}
+}
+
+func TestAppModel_Log_IsBounded(t *testing.T) {
+	model := NewAppModel()
+
+	for i := 0; i < maxLogEntries+10; i++ {
+		model.appendLog(fmt.Sprintf("event %d", i), false)
+	}
+
+	if len(model.log) != maxLogEntries {
+		t.Fatalf("Expected %d entries, got %d", maxLogEntries, len(model.log))
+	}
+
+	if model.log[0].Text != "event 10" {
+		t.Errorf("Expected oldest entries to be dropped, first entry is %q", model.log[0].Text)
+	}
+}
+
+func TestAppModel_Log_Scrolls(t *testing.T) {
+	model := NewAppModel()
+	for i := 0; i < logPageSize+5; i++ {
+		model.appendLog(fmt.Sprintf("event %d", i), false)
+	}
+
+	_, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'L'}})
+	if model.logScroll != 5 {
+		t.Errorf("Expected log to open on the latest entries (scroll 5), got %d", model.logScroll)
+	}
+
+	_, _ = model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	if model.logScroll != 5 {
+		t.Errorf("Expected scroll to stop at the end, got %d", model.logScroll)
+	}
+
+	_, _ = model.Update(tea.KeyMsg{Type: tea.KeyUp})
+	if model.logScroll != 4 {
+		t.Errorf("Expected scroll to move up, got %d", model.logScroll)
+	}
+
+	if !strings.Contains(model.View(), "event 4") {
+		t.Error("Expected scrolled view to show earlier entries")
+	}
+}
+
+func TestAppModel_Log_KeyIgnoredWhilePickerOpen(t *testing.T) {
+	model := newFixtureAppModel()
+	model.fixtureModel.showDatePicker = true
+	model.fixtureModel.dateTimePicker = NewDateTimePickerModel("herchu", "webbi", "Elite", 1, 1, 1, time.Time{})
+
+	_, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'L'}})
+	if model.showLog {
+		t.Error("Expected L not to open the log while the date picker has focus")
+	}
+}
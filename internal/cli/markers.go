@@ -0,0 +1,75 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"carca-cli/internal/fixtures"
+)
+
+// StatusMarkers are the symbols shown in the PLAYED column for each kind of match
+type StatusMarkers struct {
+	Played   string
+	Unplayed string
+	Bye      string
+	Walkover string
+}
+
+// DefaultStatusMarkers keeps the ✓/○ markers the fixture has always shown
+var DefaultStatusMarkers = StatusMarkers{Played: "✓", Unplayed: "○", Bye: "○", Walkover: "✓"}
+
+// String returns the markers in the "played,unplayed,bye,walkover" form used on the command line
+func (s *StatusMarkers) String() string {
+	return strings.Join([]string{s.Played, s.Unplayed, s.Bye, s.Walkover}, ",")
+}
+
+// Set parses "played,unplayed,bye,walkover" markers, so StatusMarkers can be used as a flag
+func (s *StatusMarkers) Set(value string) error {
+	parts := strings.Split(value, ",")
+	if len(parts) != 4 {
+		return fmt.Errorf("expected 4 comma-separated markers (played,unplayed,bye,walkover), got %d", len(parts))
+	}
+
+	markers := StatusMarkers{
+		Played:   strings.TrimSpace(parts[0]),
+		Unplayed: strings.TrimSpace(parts[1]),
+		Bye:      strings.TrimSpace(parts[2]),
+		Walkover: strings.TrimSpace(parts[3]),
+	}
+	if err := markers.Validate(); err != nil {
+		return err
+	}
+
+	*s = markers
+	return nil
+}
+
+// Validate checks that every match state has a marker
+func (s *StatusMarkers) Validate() error {
+	for _, marker := range []struct{ state, value string }{
+		{"played", s.Played},
+		{"unplayed", s.Unplayed},
+		{"bye", s.Bye},
+		{"walkover", s.Walkover},
+	} {
+		if marker.value == "" {
+			return fmt.Errorf("missing %s marker", marker.state)
+		}
+	}
+
+	return nil
+}
+
+// For returns the marker of the match's state
+func (s *StatusMarkers) For(match *fixtures.Match) string {
+	switch {
+	case match.IsBye():
+		return s.Bye
+	case match.Played && match.Walkover:
+		return s.Walkover
+	case match.Played:
+		return s.Played
+	default:
+		return s.Unplayed
+	}
+}
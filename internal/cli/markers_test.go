@@ -0,0 +1,84 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"carca-cli/internal/fixtures"
+)
+
+func TestStatusMarkers_Set(t *testing.T) {
+	var markers StatusMarkers
+	if err := markers.Set("•, ·,-,W"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := StatusMarkers{Played: "•", Unplayed: "·", Bye: "-", Walkover: "W"}
+	if markers != expected {
+		t.Errorf("Expected %+v, got %+v", expected, markers)
+	}
+	if markers.String() != "•,·,-,W" {
+		t.Errorf("Expected the flag form back, got %q", markers.String())
+	}
+
+	for _, invalid := range []string{"", "•,·", "•,·,-,W,X", "•,,-,W"} {
+		markers := DefaultStatusMarkers
+		if err := markers.Set(invalid); err == nil {
+			t.Errorf("Expected an error for %q", invalid)
+		}
+		if markers != DefaultStatusMarkers {
+			t.Errorf("Expected %q to leave the markers unchanged, got %+v", invalid, markers)
+		}
+	}
+}
+
+func TestStatusMarkers_For(t *testing.T) {
+	markers := StatusMarkers{Played: "P", Unplayed: "U", Bye: "B", Walkover: "W"}
+
+	testCases := []struct {
+		match    *fixtures.Match
+		expected string
+	}{
+		{&fixtures.Match{HomePlayer: "herchu", AwayPlayer: "webbi", Played: true}, "P"},
+		{&fixtures.Match{HomePlayer: "herchu", AwayPlayer: "webbi"}, "U"},
+		{&fixtures.Match{HomePlayer: "herchu", AwayPlayer: "webbi", Played: true, Walkover: true}, "W"},
+		{&fixtures.Match{HomePlayer: "herchu", AwayPlayer: ""}, "B"},
+		{&fixtures.Match{HomePlayer: "bye", AwayPlayer: "webbi"}, "B"},
+	}
+
+	for _, tc := range testCases {
+		if got := markers.For(tc.match); got != tc.expected {
+			t.Errorf("Expected %q for %+v, got %q", tc.expected, tc.match, got)
+		}
+	}
+}
+
+func TestFixtureModel_View_CustomStatusMarkers(t *testing.T) {
+	model := NewFixtureModel(&fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, Matches: []*fixtures.Match{
+				{ID: 1, HomePlayer: "herchu", AwayPlayer: "webbi", HomeScore: 2, AwayScore: 1, Played: true},
+				{ID: 2, HomePlayer: "Lord Trooper", AwayPlayer: "alehrosario"},
+			}},
+		},
+	})
+	model.SetStatusMarkers(StatusMarkers{Played: "•", Unplayed: "·", Bye: "-", Walkover: "W"})
+
+	view := model.View()
+	for _, want := range []string{"•", "·"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("Expected custom marker %q in the table, got:\n%s", want, view)
+		}
+	}
+	for _, unwanted := range []string{"✓", "○"} {
+		if strings.Contains(view, unwanted) {
+			t.Errorf("Expected default marker %q to be replaced, got:\n%s", unwanted, view)
+		}
+	}
+
+	model.SetStatusMarkers(StatusMarkers{Played: "x"})
+	if model.markers.Played != "•" {
+		t.Error("Expected an incomplete marker set to be ignored")
+	}
+}
@@ -55,15 +55,21 @@ func (m *MenuModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case tea.KeyEnter:
 			// Handle menu selection
 			switch m.cursor {
+			case 0: // Create Tournament
+				return m, func() tea.Msg {
+					return CreateTournamentSelectMsg{}
+				}
 			case 1: // View Fixture
 				return m, func() tea.Msg {
 					return ViewFixtureSelectMsg{}
 				}
+			case 2: // View Positions
+				return m, func() tea.Msg {
+					return ViewPositionsSelectMsg{}
+				}
 			case 3: // Exit
 				return m, tea.Quit
 			default:
-				// For now, other options don't do anything
-				// TODO: Implement create tournament, view positions
 				return m, nil
 			}
 		case tea.KeyRunes:
@@ -177,6 +177,34 @@ func TestMenuModel_Update_SelectViewFixture(t *testing.T) {
 	}
 }
 
+func TestMenuModel_Update_SelectViewPositions(t *testing.T) {
+	model := NewMenuModel()
+	model.cursor = 2 // View Positions option
+
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("Expected command when selecting View Positions")
+	}
+
+	if _, ok := cmd().(ViewPositionsSelectMsg); !ok {
+		t.Error("Expected ViewPositionsSelectMsg")
+	}
+}
+
+func TestMenuModel_Update_SelectCreateTournament(t *testing.T) {
+	model := NewMenuModel()
+	model.cursor = 0 // Create Tournament option
+
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("Expected command when selecting Create Tournament")
+	}
+
+	if _, ok := cmd().(CreateTournamentSelectMsg); !ok {
+		t.Error("Expected CreateTournamentSelectMsg")
+	}
+}
+
 func TestMenuModel_HandleViewFixtureFlow(t *testing.T) {
 	model := NewMenuModel()
 
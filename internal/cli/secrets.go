@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/zalando/go-keyring"
+)
+
+// keyringService is the OS keyring service the BGA credentials are stored under
+const keyringService = "carca"
+
+// Keyring accounts holding each credential, named after the variables they replace
+const (
+	keyringUserAccount = "BGA_USER"
+	keyringPassAccount = "BGA_PASS"
+)
+
+// StoreCredentials saves the BGA credentials in the OS keyring
+func StoreCredentials(user, pass string) error {
+	if err := keyring.Set(keyringService, keyringUserAccount, user); err != nil {
+		return fmt.Errorf("error storing BGA_USER in keyring: %w", err)
+	}
+
+	if err := keyring.Set(keyringService, keyringPassAccount, pass); err != nil {
+		return fmt.Errorf("error storing BGA_PASS in keyring: %w", err)
+	}
+
+	return nil
+}
+
+// LoadCredentials reads the BGA credentials from the OS keyring
+// It fails when no keyring is available, as in CI, or nothing was stored yet
+func LoadCredentials() (user, pass string, err error) {
+	user, err = keyring.Get(keyringService, keyringUserAccount)
+	if err != nil {
+		return "", "", fmt.Errorf("error reading BGA_USER from keyring: %w", err)
+	}
+
+	pass, err = keyring.Get(keyringService, keyringPassAccount)
+	if err != nil {
+		return "", "", fmt.Errorf("error reading BGA_PASS from keyring: %w", err)
+	}
+
+	return user, pass, nil
+}
@@ -0,0 +1,90 @@
+package cli
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/zalando/go-keyring"
+)
+
+// mockKeyring swaps the OS keyring for an in-memory one, emptied again when the test ends
+func mockKeyring(t *testing.T) {
+	t.Helper()
+
+	keyring.MockInit()
+	t.Cleanup(func() {
+		_ = keyring.DeleteAll(keyringService)
+	})
+}
+
+func TestStoreCredentials_RoundTrip(t *testing.T) {
+	mockKeyring(t)
+
+	if err := StoreCredentials("keyuser", "key pass=1"); err != nil {
+		t.Fatalf("Expected no error storing credentials, got: %v", err)
+	}
+
+	user, pass, err := LoadCredentials()
+	if err != nil {
+		t.Fatalf("Expected no error loading credentials, got: %v", err)
+	}
+
+	if user != "keyuser" || pass != "key pass=1" {
+		t.Errorf("Expected keyuser/%q, got %s/%q", "key pass=1", user, pass)
+	}
+}
+
+func TestLoadCredentials_NothingStored(t *testing.T) {
+	mockKeyring(t)
+
+	_, _, err := LoadCredentials()
+	if !errors.Is(err, keyring.ErrNotFound) {
+		t.Errorf("Expected keyring.ErrNotFound, got: %v", err)
+	}
+}
+
+func TestGetOrPromptCredentials_PrefersKeyring(t *testing.T) {
+	mockKeyring(t)
+
+	os.Setenv("BGA_USER", "envuser")
+	os.Setenv("BGA_PASS", "envpass")
+	defer func() {
+		os.Unsetenv("BGA_USER")
+		os.Unsetenv("BGA_PASS")
+	}()
+
+	if err := StoreCredentials("keyuser", "keypass"); err != nil {
+		t.Fatalf("Expected no error storing credentials, got: %v", err)
+	}
+
+	user, pass, err := GetOrPromptCredentials(false)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if user != "keyuser" || pass != "keypass" {
+		t.Errorf("Expected the keyring credentials, got %s/%s", user, pass)
+	}
+}
+
+func TestGetOrPromptCredentials_FallsBackWithoutKeyring(t *testing.T) {
+	keyring.MockInitWithError(errors.New("no keyring available"))
+	t.Cleanup(keyring.MockInit)
+
+	os.Setenv("BGA_USER", "envuser")
+	os.Setenv("BGA_PASS", "envpass")
+	defer func() {
+		os.Unsetenv("BGA_USER")
+		os.Unsetenv("BGA_PASS")
+	}()
+
+	user, pass, err := GetOrPromptCredentials(false)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if user != "envuser" || pass != "envpass" {
+		t.Errorf("Expected the environment credentials, got %s/%s", user, pass)
+	}
+}
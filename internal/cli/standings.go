@@ -0,0 +1,237 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"carca-cli/internal/fixtures"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+)
+
+// ViewPositionsSelectMsg is sent when user selects "View Positions" from main menu
+type ViewPositionsSelectMsg struct{}
+
+// formLength is how many recent results the FORM column shows
+const formLength = 5
+
+// standingsColumns are the optional columns of the positions table
+type standingsColumns struct {
+	tiebreak bool // DIF or PF, the score mode's tiebreak
+	form     bool // FORM, the last formLength results as W/L/D letters
+}
+
+// StandingsModel shows the positions table of a division
+type StandingsModel struct {
+	division         *fixtures.Division
+	statusMessage    string
+	style            lipgloss.Style
+	width            int
+	height           int
+	statusClearDelay time.Duration
+	columns          standingsColumns
+}
+
+// NewStandingsModel creates a standings screen for the division
+func NewStandingsModel(division *fixtures.Division) *StandingsModel {
+	return &StandingsModel{
+		division: division,
+		style: lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#7D56F4")).
+			Bold(true),
+		columns:          standingsColumns{tiebreak: true},
+		statusClearDelay: DefaultStatusClearDelay,
+	}
+}
+
+// SetStatusClearDelay sets how long status messages stay on screen
+func (m *StandingsModel) SetStatusClearDelay(delay time.Duration) {
+	if delay > 0 {
+		m.statusClearDelay = delay
+	}
+}
+
+// SetSize sets the terminal size the positions screen has to fit in, zero meaning unknown
+func (m *StandingsModel) SetSize(width, height int) {
+	m.width, m.height = width, height
+}
+
+// Init initializes the standings model (required by Bubble Tea)
+func (m *StandingsModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update handles keys on the standings screen: d and f toggle the tiebreak and FORM columns,
+// c and C copy the table as a code block or as CSV and esc/q go back to the menu
+func (m *StandingsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.SetSize(msg.Width, msg.Height)
+		return m, nil
+	case clearStatusMsg:
+		m.statusMessage = ""
+		return m, nil
+	}
+
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	switch keyMsg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+	case "d":
+		m.columns.tiebreak = !m.columns.tiebreak
+	case "f":
+		m.columns.form = !m.columns.form
+	case "c":
+		return m.handleCopyCodeBlock()
+	case "C":
+		return m.handleCopyCSV()
+	case "esc", "q":
+		return m, func() tea.Msg { return BackToMenuMsg{} }
+	}
+
+	return m, nil
+}
+
+// View renders the positions table
+func (m *StandingsModel) View() string {
+	title := m.style.Render(fmt.Sprintf("Division %s - Positions", m.division.Name))
+	s := fmt.Sprintf("\n%s\n\n", title)
+
+	standings := fixtures.ComputeStandings(m.division)
+	if len(standings) == 0 {
+		s += lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#FF6B6B")).
+			Render("No players in this division")
+	} else {
+		s += formatStandingsTable(standings, m.columns, m.division)
+	}
+
+	help := "# position, PJ played, PG won, PP lost"
+	if m.columns.tiebreak {
+		help += ", " + tiebreakHeader() + " " + fixtures.FixtureScoreMode.TiebreakName()
+	}
+	help += ", PTS points"
+	if m.columns.form {
+		help += fmt.Sprintf(", FORM last %d results", formLength)
+	}
+	help += "\nPress d to toggle " + tiebreakHeader() + ", f to toggle FORM, " +
+		"c/C to copy as a code block/CSV, esc/q to go back."
+	s += "\n\n" + wrapToWidth(help, m.width) + "\n"
+
+	if m.statusMessage != "" {
+		s += "\n" + lipgloss.NewStyle().Foreground(lipgloss.Color("#50C878")).Bold(true).Render(m.statusMessage) + "\n"
+	}
+
+	return s
+}
+
+// formatStandingsTable renders the standings in the same table style as the fixture, with the chosen optional columns
+func formatStandingsTable(standings []fixtures.PlayerStanding, columns standingsColumns, d *fixtures.Division) string {
+	headers, rows := standingsCells(standings, columns, d)
+
+	t := table.New().
+		Border(lipgloss.NormalBorder()).
+		BorderStyle(lipgloss.NewStyle().Foreground(lipgloss.Color("99"))).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			switch {
+			case row == table.HeaderRow:
+				return lipgloss.NewStyle().Foreground(lipgloss.Color("#7D56F4")).Bold(true)
+			default:
+				return lipgloss.NewStyle().Foreground(lipgloss.Color("252"))
+			}
+		}).
+		Headers(headers...).
+		Rows(rows...)
+
+	return t.Render()
+}
+
+// standingsCells lays out the header and one row per player of the positions table, with the chosen optional columns
+func standingsCells(
+	standings []fixtures.PlayerStanding,
+	columns standingsColumns,
+	d *fixtures.Division,
+) (headers []string, rows [][]string) {
+	headers = []string{"#", "PLAYER", "PJ", "PG", "PP"}
+	if columns.tiebreak {
+		headers = append(headers, tiebreakHeader())
+	}
+	headers = append(headers, "PTS")
+	if columns.form {
+		headers = append(headers, "FORM")
+	}
+
+	ranks := standingRanks(standings)
+	for i, standing := range standings {
+		row := []string{
+			strconv.Itoa(ranks[i]),
+			standing.Player,
+			strconv.Itoa(standing.Played),
+			strconv.Itoa(standing.Won),
+			strconv.Itoa(standing.Lost),
+		}
+		if columns.tiebreak {
+			row = append(row, formatTiebreak(standing.Tiebreak))
+		}
+		row = append(row, strconv.Itoa(standing.Points))
+		if columns.form {
+			form := strings.Join(fixtures.RecentForm(d, standing.Player, formLength), "")
+			if form == "" {
+				form = "-"
+			}
+			row = append(row, form)
+		}
+
+		rows = append(rows, row)
+	}
+
+	return headers, rows
+}
+
+// standingRanks returns the displayed position of each ordered standing
+// Players level on points and game difference share a position and the next one skips the places they take
+func standingRanks(standings []fixtures.PlayerStanding) []int {
+	ranks := make([]int, len(standings))
+
+	for i, standing := range standings {
+		if i > 0 && tiedStandings(standings[i-1], standing) {
+			ranks[i] = ranks[i-1]
+			continue
+		}
+
+		ranks[i] = i + 1
+	}
+
+	return ranks
+}
+
+// tiedStandings reports whether two standings are level on every tiebreak but the name
+func tiedStandings(a, b fixtures.PlayerStanding) bool {
+	return a.Points == b.Points && a.Tiebreak == b.Tiebreak
+}
+
+// tiebreakHeader names the tiebreak column: DIF for the game difference of sets, PF for points scored (a favor)
+func tiebreakHeader() string {
+	if fixtures.FixtureScoreMode == fixtures.ScorePoints {
+		return "PF"
+	}
+
+	return "DIF"
+}
+
+// formatTiebreak signs a game difference; points scored are shown as they are
+func formatTiebreak(value int) string {
+	if fixtures.FixtureScoreMode == fixtures.ScorePoints {
+		return strconv.Itoa(value)
+	}
+
+	return fmt.Sprintf("%+d", value)
+}
@@ -0,0 +1,84 @@
+package cli
+
+import (
+	"encoding/csv"
+	"io"
+	"strings"
+
+	"carca-cli/internal/fixtures"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+)
+
+// handleCopyCodeBlock handles 'c' key to copy the positions table fenced for pasting into Discord
+func (m *StandingsModel) handleCopyCodeBlock() (tea.Model, tea.Cmd) {
+	standings := fixtures.ComputeStandings(m.division)
+
+	switch {
+	case len(standings) == 0:
+		m.statusMessage = "No standings to copy yet"
+	case clipboardWriteAll(standingsCodeBlock(standings, m.columns, m.division)) != nil:
+		m.statusMessage = "Failed to copy standings to clipboard"
+	default:
+		m.statusMessage = "Standings copied as a code block"
+	}
+
+	return m, clearAfter(m.statusClearDelay)
+}
+
+// handleCopyCSV handles 'C' key to copy the positions table as CSV with a header, for pasting into a spreadsheet
+func (m *StandingsModel) handleCopyCSV() (tea.Model, tea.Cmd) {
+	standings := fixtures.ComputeStandings(m.division)
+
+	var csvText strings.Builder
+	switch {
+	case len(standings) == 0:
+		m.statusMessage = "No standings to copy yet"
+	case writeStandingsCSV(&csvText, standings, m.columns, m.division) != nil:
+		m.statusMessage = "Failed to write standings as CSV"
+	case clipboardWriteAll(csvText.String()) != nil:
+		m.statusMessage = "Failed to copy standings to clipboard"
+	default:
+		m.statusMessage = "Standings copied as CSV"
+	}
+
+	return m, clearAfter(m.statusClearDelay)
+}
+
+// writeStandingsCSV writes the header and one record per player of the positions table as CSV
+func writeStandingsCSV(
+	w io.Writer,
+	standings []fixtures.PlayerStanding,
+	columns standingsColumns,
+	d *fixtures.Division,
+) error {
+	headers, rows := standingsCells(standings, columns, d)
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(headers); err != nil {
+		return err
+	}
+	if err := writer.WriteAll(rows); err != nil {
+		return err
+	}
+
+	return writer.Error()
+}
+
+// standingsCodeBlock wraps the unstyled positions table in a triple-backtick block so chat keeps it monospaced
+func standingsCodeBlock(standings []fixtures.PlayerStanding, columns standingsColumns, d *fixtures.Division) string {
+	return "```\n" + renderStandings(standings, columns, d) + "\n```"
+}
+
+// renderStandings draws the positions table as plain text, without the colors of the screen
+func renderStandings(standings []fixtures.PlayerStanding, columns standingsColumns, d *fixtures.Division) string {
+	headers, rows := standingsCells(standings, columns, d)
+
+	return table.New().
+		Border(lipgloss.NormalBorder()).
+		Headers(headers...).
+		Rows(rows...).
+		Render()
+}
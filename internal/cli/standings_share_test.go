@@ -0,0 +1,91 @@
+package cli
+
+import (
+	"encoding/csv"
+	"strings"
+	"testing"
+
+	"carca-cli/internal/fixtures"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// shareStandingsDivision is a small played division for the copy tests
+func shareStandingsDivision() *fixtures.Division {
+	return &fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, Matches: []*fixtures.Match{
+				{ID: 1, HomePlayer: "herchu", HomeScore: 2, AwayScore: 1, AwayPlayer: "webbi", Played: true},
+				{ID: 2, HomePlayer: "Lord Trooper", HomeScore: 2, AwayScore: 0, AwayPlayer: "alehrosario", Played: true},
+			}},
+		},
+	}
+}
+
+func TestStandingsModel_CopyCodeBlock(t *testing.T) {
+	copied := stubClipboard(t)
+	model := NewStandingsModel(shareStandingsDivision())
+
+	updatedModel, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
+
+	if len(*copied) != 1 {
+		t.Fatalf("Expected the standings copied once, got %d copies", len(*copied))
+	}
+	text := (*copied)[0]
+	if !strings.HasPrefix(text, "```\n") || !strings.HasSuffix(text, "\n```") {
+		t.Errorf("Expected the copy fenced in triple backticks, got:\n%s", text)
+	}
+
+	// Every table line has the same width, so the columns line up in a monospace block
+	lines := strings.Split(strings.Trim(text, "`\n"), "\n")
+	for _, line := range lines {
+		if width := len([]rune(line)); width != len([]rune(lines[0])) {
+			t.Errorf("Expected aligned table lines, got %q with width %d", line, width)
+		}
+	}
+	for _, want := range []string{"PLAYER", "PTS", "Lord Trooper", "alehrosario"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("Expected the copied table to contain %q, got:\n%s", want, text)
+		}
+	}
+	if strings.Contains(text, "\x1b[") {
+		t.Errorf("Expected plain text without colors, got %q", text)
+	}
+
+	if got := updatedModel.(*StandingsModel).statusMessage; got != "Standings copied as a code block" {
+		t.Errorf("Expected the copy confirmed, got status %q", got)
+	}
+	if cmd == nil {
+		t.Error("Expected the status message to be cleared later")
+	}
+}
+
+func TestStandingsModel_CopyCSV(t *testing.T) {
+	copied := stubClipboard(t)
+	model := NewStandingsModel(shareStandingsDivision())
+
+	updatedModel, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'C'}})
+
+	if len(*copied) != 1 {
+		t.Fatalf("Expected the standings copied once, got %d copies", len(*copied))
+	}
+	records, err := csv.NewReader(strings.NewReader((*copied)[0])).ReadAll()
+	if err != nil {
+		t.Fatalf("Expected valid CSV, got %v:\n%s", err, (*copied)[0])
+	}
+
+	if got := strings.Join(records[0], ","); got != "#,PLAYER,PJ,PG,PP,DIF,PTS" {
+		t.Errorf("Unexpected CSV header %q", got)
+	}
+	if len(records) != 5 {
+		t.Fatalf("Expected a header and a row per player, got %d records", len(records))
+	}
+	if got := strings.Join(records[1], ","); got != "1,Lord Trooper,1,1,0,+2,3" {
+		t.Errorf("Expected the leader first, got %q", got)
+	}
+
+	if got := updatedModel.(*StandingsModel).statusMessage; got != "Standings copied as CSV" {
+		t.Errorf("Expected the copy confirmed, got status %q", got)
+	}
+}
@@ -0,0 +1,221 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"carca-cli/internal/fixtures"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestStandingsModel_View(t *testing.T) {
+	division := &fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, Matches: []*fixtures.Match{
+				{ID: 1, HomePlayer: "herchu", HomeScore: 2, AwayScore: 1, AwayPlayer: "webbi", Played: true},
+				{ID: 2, HomePlayer: "Lord Trooper", HomeScore: 2, AwayScore: 0, AwayPlayer: "alehrosario", Played: true},
+			}},
+			{Number: 2, Matches: []*fixtures.Match{
+				{ID: 3, HomePlayer: "herchu", HomeScore: 2, AwayScore: 0, AwayPlayer: "Lord Trooper", Played: true},
+			}},
+		},
+	}
+
+	view := NewStandingsModel(division).View()
+
+	for _, want := range []string{"Division Elite - Positions", "PLAYER", "PJ", "PTS", "herchu", "Lord Trooper", "webbi"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("Expected view to contain %q, got:\n%s", want, view)
+		}
+	}
+
+	var herchuLine string
+	for _, line := range strings.Split(view, "\n") {
+		if strings.Contains(line, "herchu") {
+			herchuLine = line
+		}
+	}
+
+	fields := strings.Fields(strings.NewReplacer("│", " ").Replace(herchuLine))
+	if strings.Join(fields, " ") != "1 herchu 2 2 0 +3 6" {
+		t.Errorf("Expected herchu first with 2 played, 2 won, +3 and 6 points, got %q", herchuLine)
+	}
+
+	if strings.Index(view, "herchu") > strings.Index(view, "Lord Trooper") {
+		t.Error("Expected the leader to be listed first")
+	}
+}
+
+func TestStandingsModel_View_TiedPlayersShareRank(t *testing.T) {
+	division := &fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, Matches: []*fixtures.Match{
+				{ID: 1, HomePlayer: "herchu", HomeScore: 2, AwayScore: 0, AwayPlayer: "webbi", Played: true},
+				{ID: 2, HomePlayer: "Lord Trooper", HomeScore: 2, AwayScore: 1, AwayPlayer: "alehrosario", Played: true},
+				{ID: 3, HomePlayer: "kuro", HomeScore: 2, AwayScore: 1, AwayPlayer: "zeta", Played: true},
+			}},
+		},
+	}
+
+	ranks := map[string]string{}
+	for _, line := range strings.Split(NewStandingsModel(division).View(), "\n") {
+		fields := strings.Fields(strings.NewReplacer("│", " ").Replace(line))
+		if len(fields) >= 7 {
+			ranks[strings.Join(fields[1:len(fields)-5], " ")] = fields[0]
+		}
+	}
+
+	want := map[string]string{
+		"herchu":       "1",
+		"kuro":         "2",
+		"Lord Trooper": "2",
+		"alehrosario":  "4",
+		"zeta":         "4",
+		"webbi":        "6",
+	}
+	for player, rank := range want {
+		if ranks[player] != rank {
+			t.Errorf("Expected %s to be shown at position %s, got %q", player, rank, ranks[player])
+		}
+	}
+}
+
+func TestStandingsModel_ColumnToggles(t *testing.T) {
+	model := NewStandingsModel(&fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, Matches: []*fixtures.Match{
+				{ID: 1, HomePlayer: "herchu", HomeScore: 2, AwayScore: 1, AwayPlayer: "webbi", Played: true},
+			}},
+			{Number: 2, Matches: []*fixtures.Match{
+				{ID: 2, HomePlayer: "webbi", HomeScore: 2, AwayScore: 0, AwayPlayer: "herchu", Played: true},
+			}},
+		},
+	})
+
+	if header := standingsHeader(model.View()); header != "# PLAYER PJ PG PP DIF PTS" {
+		t.Fatalf("Expected DIF shown and FORM hidden by default, got %q", header)
+	}
+
+	_, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'f'}})
+	_, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'d'}})
+	view := model.View()
+
+	if header := standingsHeader(view); header != "# PLAYER PJ PG PP PTS FORM" {
+		t.Fatalf("Expected FORM shown and DIF hidden after toggling, got %q", header)
+	}
+
+	for _, line := range strings.Split(view, "\n") {
+		fields := strings.Fields(strings.NewReplacer("│", " ").Replace(line))
+		if len(fields) > 1 && fields[1] == "herchu" && strings.Join(fields, " ") != "2 herchu 2 1 1 3 WL" {
+			t.Errorf("Expected herchu's row without DIF and with form WL, got %q", line)
+		}
+	}
+}
+
+func TestStandingsModel_PointsModeShowsPointsScored(t *testing.T) {
+	previous := fixtures.FixtureScoreMode
+	fixtures.FixtureScoreMode = fixtures.ScorePoints
+	t.Cleanup(func() { fixtures.FixtureScoreMode = previous })
+
+	view := NewStandingsModel(&fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, Matches: []*fixtures.Match{
+				{ID: 1, HomePlayer: "herchu", HomeScore: 187, AwayScore: 160, AwayPlayer: "webbi", Played: true},
+			}},
+		},
+	}).View()
+
+	if header := standingsHeader(view); header != "# PLAYER PJ PG PP PF PTS" {
+		t.Fatalf("Expected a PF column in points mode, got %q", header)
+	}
+
+	for _, line := range strings.Split(view, "\n") {
+		fields := strings.Fields(strings.NewReplacer("│", " ").Replace(line))
+		if len(fields) > 1 && fields[1] == "webbi" && strings.Join(fields, " ") != "2 webbi 1 0 1 160 0" {
+			t.Errorf("Expected webbi's 160 points scored, got %q", line)
+		}
+	}
+}
+
+// standingsHeader returns the column headers of the positions table in view, separated by single spaces
+func standingsHeader(view string) string {
+	for _, line := range strings.Split(view, "\n") {
+		if strings.Contains(line, "PLAYER") {
+			return strings.Join(strings.Fields(strings.NewReplacer("│", " ").Replace(line)), " ")
+		}
+	}
+
+	return ""
+}
+
+func TestStandingsModel_Update_BackToMenu(t *testing.T) {
+	model := NewStandingsModel(&fixtures.Division{Name: "Elite"})
+
+	for _, key := range []tea.KeyMsg{{Type: tea.KeyEsc}, {Type: tea.KeyRunes, Runes: []rune("q")}} {
+		_, cmd := model.Update(key)
+		if cmd == nil {
+			t.Fatalf("Expected a command for %q", key.String())
+		}
+
+		if _, ok := cmd().(BackToMenuMsg); !ok {
+			t.Errorf("Expected %q to go back to the menu", key.String())
+		}
+	}
+}
+
+func TestAppModel_ViewPositionsFlow(t *testing.T) {
+	model := NewAppModel()
+
+	_, _ = model.Update(ViewPositionsSelectMsg{})
+	if model.GetCurrentScreen() != ScreenDivisionSelect {
+		t.Fatalf("Expected division selection first, got %v", model.GetCurrentScreen())
+	}
+
+	_, _ = model.Update(DivisionSelectMsg{
+		Division: "Elite",
+		Filename: "../../data/Liga Argentina - 1° Temporada - E-Fixture.csv",
+	})
+
+	if model.GetCurrentScreen() != ScreenStandings || model.standingsModel == nil {
+		t.Fatalf("Expected the standings screen, got %v", model.GetCurrentScreen())
+	}
+
+	if view := model.View(); !strings.Contains(view, "herchu") || !strings.Contains(view, "PTS") {
+		t.Errorf("Expected the loaded division's standings, got:\n%s", view)
+	}
+
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if cmd == nil {
+		t.Fatal("Expected esc to leave the standings")
+	}
+
+	_, _ = model.Update(cmd())
+	if model.GetCurrentScreen() != ScreenMenu || model.standingsModel != nil {
+		t.Errorf("Expected to be back on the menu, got %v", model.GetCurrentScreen())
+	}
+}
+
+func TestStandingsModel_WindowSize_FitsNarrowTerminal(t *testing.T) {
+	model := NewStandingsModel(&fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, Matches: []*fixtures.Match{
+				{ID: 1, HomePlayer: "herchu", HomeScore: 2, AwayScore: 1, AwayPlayer: "webbi", Played: true},
+			}},
+		},
+	})
+
+	_, _ = model.Update(tea.WindowSizeMsg{Width: 40, Height: 20})
+
+	for _, line := range strings.Split(model.View(), "\n") {
+		if width := lipgloss.Width(line); width > 40 {
+			t.Errorf("Expected every line to fit in 40 columns, got %d: %q", width, line)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// firstRunTips are shown once, the first time the app starts
+var firstRunTips = []string{
+	"Pick \"View Fixture\" in the menu, then a division, to see its rounds and matches",
+	"Credentials come from BGA_USER/BGA_PASS, a .env file, or a prompt on first login",
+	"Press c on an unplayed match to schedule its BGA tournament, a to create every scheduled one",
+	"Press Enter on a played match to copy its tournament link",
+	"Press L anywhere to open the session log of created tournaments and errors",
+}
+
+// DefaultTipsStatePath returns the file marking the first-run tips as seen, under the user config dir
+func DefaultTipsStatePath() (string, error) {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate user config dir: %w", err)
+	}
+
+	return filepath.Join(configDir, "carca", "tips_seen"), nil
+}
+
+// SetTipsStatePath shows the first-run tips unless the state file at path says they were already seen
+func (m *AppModel) SetTipsStatePath(path string) {
+	m.tipsStatePath = path
+
+	_, err := os.Stat(path)
+	m.showTips = errors.Is(err, fs.ErrNotExist)
+}
+
+// updateTips dismisses the tips on any key and remembers they were seen
+func (m *AppModel) updateTips(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if msg.Type == tea.KeyCtrlC {
+		return m, tea.Quit
+	}
+
+	m.showTips = false
+
+	if err := markTipsSeen(m.tipsStatePath); err != nil {
+		m.appendLog(fmt.Sprintf("Could not remember the tips were seen: %v", err), true)
+	}
+
+	return m, nil
+}
+
+// markTipsSeen creates the state file so the tips are not shown again
+func markTipsSeen(path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("failed to create state dir: %w", err)
+	}
+
+	if err := os.WriteFile(path, nil, 0o600); err != nil {
+		return fmt.Errorf("failed to write tips state file: %w", err)
+	}
+
+	return nil
+}
+
+// renderTips renders the first-run tips screen
+func (m *AppModel) renderTips() string {
+	title := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#7D56F4")).
+		Bold(true).
+		Render("Welcome to Carca CLI")
+
+	s := fmt.Sprintf("\n%s\n\n", title)
+
+	for _, tip := range firstRunTips {
+		s += fmt.Sprintf("• %s\n", tip)
+	}
+
+	s += "\nPress any key to continue. These tips will not be shown again.\n"
+
+	return s
+}
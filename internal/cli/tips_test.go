@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestAppModel_Tips_ShownOnFirstRun(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "carca", "tips_seen")
+
+	model := NewAppModel()
+	model.SetTipsStatePath(path)
+
+	if !strings.Contains(model.View(), "Welcome to Carca CLI") {
+		t.Fatalf("Expected the tips screen without a state file, got:\n%s", model.View())
+	}
+
+	_, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	if model.showTips || strings.Contains(model.View(), "Welcome to Carca CLI") {
+		t.Error("Expected any key to dismiss the tips")
+	}
+
+	if model.GetCurrentScreen() != ScreenMenu {
+		t.Errorf("Expected the dismissing key not to reach the menu, got screen %v", model.GetCurrentScreen())
+	}
+
+	if _, err := os.Stat(path); err != nil {
+		t.Errorf("Expected the state file to be created, got %v", err)
+	}
+}
+
+func TestAppModel_Tips_SuppressedWhenSeen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tips_seen")
+	if err := os.WriteFile(path, nil, 0o600); err != nil {
+		t.Fatalf("Failed to write state file: %v", err)
+	}
+
+	model := NewAppModel()
+	model.SetTipsStatePath(path)
+
+	if strings.Contains(model.View(), "Welcome to Carca CLI") {
+		t.Error("Expected the tips to stay hidden once seen")
+	}
+}
@@ -5,7 +5,11 @@ import (
 	"strings"
 	"time"
 
+	"carca-cli/internal/bga"
+	"carca-cli/internal/fixtures"
+
 	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -19,19 +23,34 @@ type TournamentConfirmationModel struct {
 	detailStyle      lipgloss.Style
 	highlightStyle   lipgloss.Style
 	instructionStyle lipgloss.Style
+	nameInput        textinput.Model
+	formOptions      []string
 	title            string
 	championshipName string
 	tournamentName   string
+	previousMeetings string
 	division         string
 	homePlayer       string
 	awayPlayer       string
 	roundNumber      int
 	matchNumber      int
 	matchID          int
+	editingPlayer    playerField
 	confirmed        bool
 	canceled         bool
+	use24Hour        bool
+	showAdvanced     bool
 }
 
+// playerField identifies the player name being edited on the confirmation screen
+type playerField int
+
+const (
+	noPlayerField playerField = iota
+	homePlayerField
+	awayPlayerField
+)
+
 // TournamentConfirmedMsg is sent when the user confirms tournament creation
 type TournamentConfirmedMsg struct {
 	DateTime    time.Time
@@ -67,8 +86,8 @@ func NewTournamentConfirmationModel(
 	localTZ := selectedTime.Location()
 
 	// Generate tournament and championship names
-	championshipName := fmt.Sprintf("Division %s - 1era Temporada", division)
-	tournamentName := fmt.Sprintf("%d Fecha - Duelo %d - %s vs %s", roundNumber, matchNumber, homePlayer, awayPlayer)
+	championshipName := bga.FormatChampionshipName(division, bga.DefaultSeason)
+	tournamentName := formatTournamentName(roundNumber, matchNumber, homePlayer, awayPlayer)
 
 	return &TournamentConfirmationModel{
 		title:            "Tournament Confirmation",
@@ -82,6 +101,7 @@ func NewTournamentConfirmationModel(
 		matchID:          matchID,
 		selectedTime:     selectedTime,
 		timezone:         localTZ,
+		formOptions:      bga.FormOptionSummary(),
 		style: lipgloss.NewStyle().
 			Padding(1, 2).
 			Border(lipgloss.RoundedBorder()).
@@ -105,6 +125,35 @@ func NewTournamentConfirmationModel(
 	}
 }
 
+// formatTournamentName names a duel tournament the same way the BGA client does
+func formatTournamentName(roundNumber, matchNumber int, homePlayer, awayPlayer string) string {
+	return fmt.Sprintf("%d Fecha - Duelo %d - %s vs %s", roundNumber, matchNumber, homePlayer, awayPlayer)
+}
+
+// SetHeadToHead looks up the players' earlier Duelos in the division, shown from the home player's side
+func (m *TournamentConfirmationModel) SetHeadToHead(division *fixtures.Division) {
+	homeWins, awayWins, played := fixtures.HeadToHead(division, m.homePlayer, m.awayPlayer)
+	if played == 0 {
+		m.previousMeetings = "none"
+		return
+	}
+
+	m.previousMeetings = fmt.Sprintf("%d-%d", homeWins, awayWins)
+	if draws := played - homeWins - awayWins; draws > 0 {
+		m.previousMeetings += fmt.Sprintf(" (%d drawn)", draws)
+	}
+}
+
+// SetSeason names the championship after the division's season
+func (m *TournamentConfirmationModel) SetSeason(season int) {
+	m.championshipName = bga.FormatChampionshipName(m.division, season)
+}
+
+// SetUse24Hour switches the displayed date and time between 24-hour and 12-hour clocks
+func (m *TournamentConfirmationModel) SetUse24Hour(use24Hour bool) {
+	m.use24Hour = use24Hour
+}
+
 // Init initializes the tournament confirmation model
 func (m *TournamentConfirmationModel) Init() tea.Cmd {
 	return nil
@@ -114,7 +163,21 @@ func (m *TournamentConfirmationModel) Init() tea.Cmd {
 func (m *TournamentConfirmationModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		if m.editingPlayer != noPlayerField {
+			return m, m.updatePlayerEdit(msg)
+		}
+
 		switch {
+		case key.Matches(msg, key.NewBinding(key.WithKeys("h"))):
+			return m, m.startPlayerEdit(homePlayerField)
+
+		case key.Matches(msg, key.NewBinding(key.WithKeys("a"))):
+			return m, m.startPlayerEdit(awayPlayerField)
+
+		case key.Matches(msg, key.NewBinding(key.WithKeys("o"))):
+			m.showAdvanced = !m.showAdvanced
+			return m, nil
+
 		case key.Matches(msg, key.NewBinding(key.WithKeys("enter"))):
 			// Confirm tournament creation
 			m.confirmed = true
@@ -156,6 +219,47 @@ func (m *TournamentConfirmationModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// startPlayerEdit opens a text input prefilled with the current name of the given player
+func (m *TournamentConfirmationModel) startPlayerEdit(field playerField) tea.Cmd {
+	m.editingPlayer = field
+	m.nameInput = textinput.New()
+	m.nameInput.Prompt = ""
+	m.nameInput.CharLimit = 40
+
+	if field == homePlayerField {
+		m.nameInput.SetValue(m.homePlayer)
+	} else {
+		m.nameInput.SetValue(m.awayPlayer)
+	}
+
+	return m.nameInput.Focus()
+}
+
+// updatePlayerEdit types into the name input, applying it on Enter and discarding it on Esc
+// Only the tournament being confirmed uses the edited name, the fixture keeps the original
+func (m *TournamentConfirmationModel) updatePlayerEdit(msg tea.KeyMsg) tea.Cmd {
+	switch msg.Type {
+	case tea.KeyEnter:
+		if name := strings.TrimSpace(m.nameInput.Value()); name != "" {
+			if m.editingPlayer == homePlayerField {
+				m.homePlayer = name
+			} else {
+				m.awayPlayer = name
+			}
+			m.tournamentName = formatTournamentName(m.roundNumber, m.matchNumber, m.homePlayer, m.awayPlayer)
+		}
+		m.editingPlayer = noPlayerField
+		return nil
+	case tea.KeyEsc:
+		m.editingPlayer = noPlayerField
+		return nil
+	}
+
+	var cmd tea.Cmd
+	m.nameInput, cmd = m.nameInput.Update(msg)
+	return cmd
+}
+
 // View renders the tournament confirmation screen
 func (m *TournamentConfirmationModel) View() string {
 	if m.confirmed || m.canceled {
@@ -182,6 +286,9 @@ func (m *TournamentConfirmationModel) View() string {
 	content.WriteString(fmt.Sprintf("• Players:      %s vs %s\n",
 		m.highlightStyle.Render(m.homePlayer),
 		m.highlightStyle.Render(m.awayPlayer)))
+	if m.previousMeetings != "" {
+		content.WriteString(fmt.Sprintf("• Previous meetings: %s\n", m.highlightStyle.Render(m.previousMeetings)))
+	}
 	content.WriteString("\n")
 
 	// Scheduling Information
@@ -199,7 +306,7 @@ func (m *TournamentConfirmationModel) View() string {
 	}
 
 	content.WriteString(fmt.Sprintf("• Date & Time:  %s\n",
-		m.highlightStyle.Render(m.selectedTime.Format("Monday, January 2, 2006 at 3:04 PM"))))
+		m.highlightStyle.Render(m.selectedTime.Format(displayTimeLayout(m.use24Hour)))))
 	content.WriteString(fmt.Sprintf("• Timezone:     %s (%s)\n",
 		m.highlightStyle.Render(m.timezone.String()),
 		m.highlightStyle.Render(offsetStr)))
@@ -218,8 +325,28 @@ func (m *TournamentConfirmationModel) View() string {
 	content.WriteString("• Variants:     None\n")
 	content.WriteString("\n")
 
+	if m.showAdvanced {
+		content.WriteString(m.detailStyle.Render("Advanced (BGA form options):") + "\n")
+		for _, option := range m.formOptions {
+			content.WriteString("• " + option + "\n")
+		}
+		content.WriteString("\n")
+	}
+
+	if m.editingPlayer != noPlayerField {
+		label := "Home player:"
+		if m.editingPlayer == awayPlayerField {
+			label = "Away player:"
+		}
+		content.WriteString(fmt.Sprintf("%s %s\n", m.detailStyle.Render(label), m.nameInput.View()))
+		content.WriteString(m.instructionStyle.Render("Press Enter to apply the name • Press Esc to keep the current one"))
+
+		return m.style.Render(content.String())
+	}
+
 	// Instructions
-	instructions := "Press Enter to create tournament • Press 'e' to edit date/time • Press Esc to cancel"
+	instructions := "Press Enter to create tournament • Press 'e' to edit date/time • " +
+		"Press 'h'/'a' to edit home/away player • Press 'o' to show/hide BGA form options • Press Esc to cancel"
 	content.WriteString(m.instructionStyle.Render(instructions))
 
 	return m.style.Render(content.String())
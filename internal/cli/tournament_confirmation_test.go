@@ -1,10 +1,15 @@
 package cli
 
 import (
+	"context"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
 
+	"carca-cli/internal/bga"
+	"carca-cli/internal/fixtures"
+
 	tea "github.com/charmbracelet/bubbletea"
 )
 
@@ -181,6 +186,43 @@ func TestTournamentConfirmationModel_View(t *testing.T) {
 	}
 }
 
+func TestTournamentConfirmationModel_View_24Hour(t *testing.T) {
+	selectedTime := time.Date(2025, 3, 15, 14, 30, 0, 0, time.Local)
+	model := NewTournamentConfirmationModel("herchu", "Lord Trooper", "Elite", 1, 15, 15, selectedTime)
+	model.SetUse24Hour(true)
+
+	view := model.View()
+
+	if !strings.Contains(view, "Saturday, March 15, 2025 at 14:30") {
+		t.Errorf("Expected Date & Time line in 24-hour format, got: %s", view)
+	}
+	if strings.Contains(view, "2:30 PM") {
+		t.Errorf("Expected no 12-hour time with 24-hour display on, got: %s", view)
+	}
+}
+
+func TestFixtureModel_ConfirmationUsesDivisionSeason(t *testing.T) {
+	model := NewFixtureModel(&fixtures.Division{Name: "Elite", Season: 2})
+
+	model.Update(DateTimeSelectedMsg{
+		DateTime:    time.Date(2025, 3, 15, 14, 30, 0, 0, time.Local),
+		HomePlayer:  "herchu",
+		AwayPlayer:  "Lord Trooper",
+		Division:    "Elite",
+		RoundNumber: 1,
+		MatchNumber: 15,
+		MatchID:     15,
+	})
+
+	if model.confirmationModel == nil {
+		t.Fatal("Expected the confirmation screen to open")
+	}
+
+	if view := model.confirmationModel.View(); !strings.Contains(view, "Division Elite - 2da Temporada") {
+		t.Errorf("Expected second season championship in the confirmation, got: %s", view)
+	}
+}
+
 func TestTournamentConfirmationModel_View_ConfirmedOrCanceled(t *testing.T) {
 	model := NewTournamentConfirmationModel("player1", "player2", "Elite", 1, 15, 15, time.Now())
 
@@ -370,3 +412,143 @@ func TestTournamentConfirmationModel_Update_EditKey(t *testing.T) {
 		}
 	}
 }
+
+func TestTournamentConfirmationModel_EditPlayerName(t *testing.T) {
+	selectedTime := time.Date(2025, 3, 15, 14, 30, 0, 0, time.Local)
+	model := NewTournamentConfirmationModel("herchu", "Lord Troper", "Elite", 1, 15, 15, selectedTime)
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+	if !strings.Contains(model.View(), "Away player:") {
+		t.Fatal("Expected the away player input after pressing 'a'")
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyCtrlU})
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("Lord Trooper")})
+	model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	_, tournamentName := model.GetTournamentDetails()
+	if tournamentName != "1 Fecha - Duelo 15 - herchu vs Lord Trooper" {
+		t.Errorf("Expected the tournament name to follow the edited player, got %q", tournamentName)
+	}
+
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("Expected Enter to confirm once the edit was applied")
+	}
+
+	confirmMsg, ok := cmd().(TournamentConfirmedMsg)
+	if !ok {
+		t.Fatal("Expected TournamentConfirmedMsg")
+	}
+
+	if confirmMsg.HomePlayer != "herchu" || confirmMsg.AwayPlayer != "Lord Trooper" {
+		t.Errorf("Expected edited players herchu vs Lord Trooper, got %s vs %s", confirmMsg.HomePlayer, confirmMsg.AwayPlayer)
+	}
+
+	// The edited name, not the fixture's typo, is the account resolved and invited after launch
+	fixture := NewFixtureModel(&fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, Matches: []*fixtures.Match{{ID: 15, HomePlayer: "herchu", AwayPlayer: "Lord Troper"}}},
+		},
+	})
+	client := bga.NewMockClient("testuser", "testpass")
+	if err := client.Login(context.Background()); err != nil {
+		t.Fatalf("Failed to login mock client: %v", err)
+	}
+	client.SetPlayerIDs(map[string]int{"herchu": 84123456, "Lord Trooper": 84654321})
+	fixture.SetBGAClient(client)
+
+	_, cmd = fixture.Update(confirmMsg)
+	_, cmd = fixture.Update(runCmd(cmd))
+	created, ok := runCmd(cmd).(tournamentCreatedMsg)
+	if !ok || !created.success {
+		t.Fatalf("Expected a created tournament, got %+v", created)
+	}
+
+	_, cmd = fixture.Update(created)
+	_, cmd = fixture.Update(cmd())
+	_, _ = fixture.Update(cmd())
+
+	if got := client.InvitedPlayers(created.tournamentID); !reflect.DeepEqual(got, []int{84123456, 84654321}) {
+		t.Errorf("Expected herchu and the edited Lord Trooper invited, got %v (status %q)", got, fixture.statusMessage)
+	}
+}
+
+func TestTournamentConfirmationModel_EditPlayerName_EscKeepsName(t *testing.T) {
+	selectedTime := time.Date(2025, 3, 15, 14, 30, 0, 0, time.Local)
+	model := NewTournamentConfirmationModel("herchu", "Lord Trooper", "Elite", 1, 15, 15, selectedTime)
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("h")})
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("xyz")})
+
+	if _, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEsc}); cmd != nil {
+		t.Error("Expected Esc to close the name input without canceling the confirmation")
+	}
+
+	if model.IsCanceled() {
+		t.Error("Expected the confirmation to stay open")
+	}
+
+	_, tournamentName := model.GetTournamentDetails()
+	if tournamentName != "1 Fecha - Duelo 15 - herchu vs Lord Trooper" {
+		t.Errorf("Expected the original tournament name, got %q", tournamentName)
+	}
+}
+
+func TestTournamentConfirmationModel_View_PreviousMeetings(t *testing.T) {
+	division := &fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, Matches: []*fixtures.Match{
+				{ID: 1, HomePlayer: "herchu", AwayPlayer: "Lord Trooper", HomeScore: 2, AwayScore: 1, Played: true},
+			}},
+			{Number: 4, Matches: []*fixtures.Match{
+				{ID: 7, HomePlayer: "Lord Trooper", AwayPlayer: "herchu", HomeScore: 0, AwayScore: 2, Played: true},
+			}},
+			{Number: 8, Matches: []*fixtures.Match{
+				{ID: 15, HomePlayer: "herchu", AwayPlayer: "Lord Trooper", HomeScore: 1, AwayScore: 2, Played: true},
+			}},
+		},
+	}
+
+	model := NewTournamentConfirmationModel("herchu", "Lord Trooper", "Elite", 9, 17, 17, time.Now())
+	if strings.Contains(model.View(), "Previous meetings") {
+		t.Error("Expected no previous meetings line before the division is known")
+	}
+
+	model.SetHeadToHead(division)
+	if view := model.View(); !strings.Contains(view, "Previous meetings: 2-1") {
+		t.Errorf("Expected herchu's 2-1 record against Lord Trooper, got:\n%s", view)
+	}
+
+	model = NewTournamentConfirmationModel("herchu", "webbi", "Elite", 9, 18, 18, time.Now())
+	model.SetHeadToHead(division)
+	if view := model.View(); !strings.Contains(view, "Previous meetings: none") {
+		t.Errorf("Expected no previous meetings, got:\n%s", view)
+	}
+}
+
+func TestTournamentConfirmationModel_View_AdvancedFormOptions(t *testing.T) {
+	selectedTime := time.Date(2025, 3, 15, 14, 30, 0, 0, time.Local)
+	model := NewTournamentConfirmationModel("herchu", "Lord Trooper", "Elite", 1, 15, 15, selectedTime)
+
+	if strings.Contains(model.View(), "stage_type=") {
+		t.Error("Expected the advanced section hidden until toggled")
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("o")})
+	view := model.View()
+
+	if !strings.Contains(view, "stage_type=swissSystemV2") {
+		t.Errorf("Expected the Swiss stage type in the advanced section, got:\n%s", view)
+	}
+	if !strings.Contains(view, "mode_option_swissSystemV2_103=3") {
+		t.Errorf("Expected the best-of-3 mode option in the advanced section, got:\n%s", view)
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("o")})
+	if strings.Contains(model.View(), "stage_type=") {
+		t.Error("Expected 'o' to hide the advanced section again")
+	}
+}
@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// TournamentErrorModel represents the screen shown when creating a tournament fails
+type TournamentErrorModel struct {
+	attempt          createTournamentMsgWithDateTime
+	style            lipgloss.Style
+	headerStyle      lipgloss.Style
+	errorStyle       lipgloss.Style
+	highlightStyle   lipgloss.Style
+	instructionStyle lipgloss.Style
+	reason           string
+	use24Hour        bool
+}
+
+// TournamentErrorDismissedMsg is sent when the user gives up on a failed tournament creation
+type TournamentErrorDismissedMsg struct{}
+
+// NewTournamentErrorModel creates the error screen for a failed attempt, keeping it to retry
+func NewTournamentErrorModel(attempt createTournamentMsgWithDateTime, reason string) *TournamentErrorModel {
+	return &TournamentErrorModel{
+		attempt: attempt,
+		reason:  reason,
+		style: lipgloss.NewStyle().
+			Padding(1, 2).
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(lipgloss.Color("#FF6B6B")),
+		headerStyle: lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#FAFAFA")).
+			Background(lipgloss.Color("#FF6B6B")).
+			Padding(0, 1).
+			MarginBottom(1).
+			Bold(true),
+		errorStyle: lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#FF6B6B")).
+			Bold(true),
+		highlightStyle: lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#EE6FF8")).
+			Bold(true),
+		instructionStyle: lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#626262")).
+			Italic(true).
+			MarginTop(1),
+	}
+}
+
+// SetUse24Hour switches the displayed date and time between 24-hour and 12-hour clocks
+func (m *TournamentErrorModel) SetUse24Hour(use24Hour bool) {
+	m.use24Hour = use24Hour
+}
+
+// Init initializes the tournament error model
+func (m *TournamentErrorModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update re-dispatches the failed creation on Enter and dismisses the error on Esc
+func (m *TournamentErrorModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	switch {
+	case key.Matches(keyMsg, key.NewBinding(key.WithKeys("enter"))):
+		attempt := m.attempt
+		return m, func() tea.Msg { return attempt }
+	case key.Matches(keyMsg, key.NewBinding(key.WithKeys("esc"))):
+		return m, func() tea.Msg { return TournamentErrorDismissedMsg{} }
+	}
+
+	return m, nil
+}
+
+// View renders the failure reason with the details of the attempted tournament
+func (m *TournamentErrorModel) View() string {
+	var content strings.Builder
+
+	content.WriteString(m.headerStyle.Render("Tournament Creation Failed") + "\n\n")
+	content.WriteString(m.errorStyle.Render(m.reason) + "\n\n")
+
+	name := formatTournamentName(m.attempt.roundNum+1, m.attempt.matchNumber, m.attempt.homePlayer, m.attempt.awayPlayer)
+	content.WriteString(fmt.Sprintf("• Tournament:   %s\n", m.highlightStyle.Render(name)))
+	content.WriteString(fmt.Sprintf("• Division:     %s\n", m.highlightStyle.Render(m.attempt.division)))
+	content.WriteString(fmt.Sprintf("• Players:      %s vs %s\n",
+		m.highlightStyle.Render(m.attempt.homePlayer),
+		m.highlightStyle.Render(m.attempt.awayPlayer)))
+	content.WriteString(fmt.Sprintf("• Date & Time:  %s\n",
+		m.highlightStyle.Render(m.attempt.dateTime.Format(displayTimeLayout(m.use24Hour)))))
+
+	content.WriteString(m.instructionStyle.Render("Press Enter to retry • Press Esc to cancel"))
+
+	return m.style.Render(content.String())
+}
@@ -0,0 +1,140 @@
+package cli
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"carca-cli/internal/bga"
+	"carca-cli/internal/fixtures"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func errorTestAttempt() createTournamentMsgWithDateTime {
+	return createTournamentMsgWithDateTime{
+		homePlayer:  "herchu",
+		awayPlayer:  "Lord Trooper",
+		division:    "Elite",
+		dateTime:    time.Date(2025, 9, 20, 21, 0, 0, 0, time.UTC),
+		matchID:     15,
+		roundNum:    0,
+		matchNumber: 15,
+	}
+}
+
+func TestTournamentErrorModel_View(t *testing.T) {
+	model := NewTournamentErrorModel(errorTestAttempt(), "tournament creation failed: server error")
+	model.SetUse24Hour(true)
+
+	view := model.View()
+	for _, want := range []string{
+		"Tournament Creation Failed",
+		"tournament creation failed: server error",
+		"1 Fecha - Duelo 15 - herchu vs Lord Trooper",
+		"Elite",
+		"21:00",
+		"Press Enter to retry",
+		"Esc to cancel",
+	} {
+		if !strings.Contains(view, want) {
+			t.Errorf("Expected error screen to contain %q, got:\n%s", want, view)
+		}
+	}
+}
+
+func TestTournamentErrorModel_Update(t *testing.T) {
+	model := NewTournamentErrorModel(errorTestAttempt(), "boom")
+
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("Expected enter to retry")
+	}
+	if retry, ok := cmd().(createTournamentMsgWithDateTime); !ok || retry != errorTestAttempt() {
+		t.Errorf("Expected the same creation to be dispatched again, got %+v", retry)
+	}
+
+	_, cmd = model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if cmd == nil {
+		t.Fatal("Expected esc to dismiss the error")
+	}
+	if _, ok := cmd().(TournamentErrorDismissedMsg); !ok {
+		t.Error("Expected TournamentErrorDismissedMsg on esc")
+	}
+
+	if _, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")}); cmd != nil {
+		t.Error("Expected other keys to be ignored")
+	}
+}
+
+func TestFixtureModel_CreationFailure_ShowsErrorScreenAndRetries(t *testing.T) {
+	stubClipboard(t)
+
+	model := NewFixtureModel(&fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, Matches: []*fixtures.Match{{ID: 15, HomePlayer: "herchu", AwayPlayer: "Lord Trooper"}}},
+		},
+	})
+
+	mockClient := bga.NewMockClient("testuser", "testpass")
+	if err := mockClient.Login(context.Background()); err != nil {
+		t.Fatalf("Failed to login mock client: %v", err)
+	}
+	mockClient.SetShouldFailCreate(true)
+	model.SetBGAClient(mockClient)
+
+	_, cmd := model.Update(errorTestAttempt())
+	model.Update(runCmd(cmd))
+
+	if model.errorModel == nil {
+		t.Fatal("Expected the error screen after a failed creation")
+	}
+	if view := model.View(); !strings.Contains(view, "tournament creation failed: server error") {
+		t.Errorf("Expected the server error on screen, got:\n%s", view)
+	}
+	if !model.capturingInput() {
+		t.Error("Expected the error screen to take every key")
+	}
+
+	mockClient.SetShouldFailCreate(false)
+
+	_, cmd = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("Expected enter to retry the creation")
+	}
+
+	_, cmd = model.Update(cmd())
+	if model.errorModel != nil {
+		t.Error("Expected the error screen to close when retrying")
+	}
+
+	model.Update(runCmd(cmd))
+	if link := model.division.Rounds[0].Matches[0].BGALink; link == "" {
+		t.Errorf("Expected the retried creation to store a link, status %q", model.statusMessage)
+	}
+}
+
+func TestFixtureModel_CreationFailure_EscDismissesErrorScreen(t *testing.T) {
+	model := NewFixtureModel(&fixtures.Division{
+		Name: "Elite",
+		Rounds: []*fixtures.Round{
+			{Number: 1, Matches: []*fixtures.Match{{ID: 15, HomePlayer: "herchu", AwayPlayer: "Lord Trooper"}}},
+		},
+	})
+	model.errorModel = NewTournamentErrorModel(errorTestAttempt(), "boom")
+
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if cmd == nil {
+		t.Fatal("Expected esc to dismiss the error screen")
+	}
+
+	model.Update(cmd())
+	if model.errorModel != nil {
+		t.Error("Expected the error screen to be closed")
+	}
+	if model.statusMessage != "Tournament creation canceled" {
+		t.Errorf("Expected a canceled status, got %q", model.statusMessage)
+	}
+}
@@ -0,0 +1,25 @@
+package fixtures
+
+import "strings"
+
+// DefaultByeSentinel is how a player sitting out a round is written in fixture CSVs
+// Fixtures exported with another placeholder are parsed with WithByeSentinel
+const DefaultByeSentinel = "BYE"
+
+// IsBye reports whether a player sits the match out, written as an empty or bye sentinel opponent
+// A row without any real player is malformed rather than a bye; no tournament is ever needed for a bye
+func (m *Match) IsBye() bool {
+	homeBye, awayBye := m.isByeName(m.HomePlayer), m.isByeName(m.AwayPlayer)
+	return homeBye != awayBye
+}
+
+// isByeName reports whether a fixture player name is blank or the match's bye sentinel, ignoring case and padding
+func (m *Match) isByeName(player string) bool {
+	sentinel := m.byeSentinel
+	if sentinel == "" {
+		sentinel = DefaultByeSentinel
+	}
+
+	player = strings.TrimSpace(player)
+	return player == "" || strings.EqualFold(player, sentinel)
+}
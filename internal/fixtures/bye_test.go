@@ -0,0 +1,68 @@
+package fixtures
+
+import "testing"
+
+func TestMatch_IsBye(t *testing.T) {
+	testCases := []struct {
+		name       string
+		home, away string
+		expected   bool
+	}{
+		{"regular match", "herchu", "webbi", false},
+		{"blank away player", "herchu", "", true},
+		{"blank home player", "  ", "webbi", true},
+		{"both players blank", "", " ", false},
+		{"blank against the sentinel", "", "BYE", false},
+		{"sentinel opponent", "herchu", "BYE", true},
+		{"sentinel in lowercase", "bye", "webbi", true},
+		{"player named like a bye", "herchu", "Byeong", false},
+	}
+
+	for _, tc := range testCases {
+		match := &Match{HomePlayer: tc.home, AwayPlayer: tc.away}
+		if got := match.IsBye(); got != tc.expected {
+			t.Errorf("%s: expected IsBye %v, got %v", tc.name, tc.expected, got)
+		}
+	}
+}
+
+func TestParseDivision_WithByeSentinel(t *testing.T) {
+	csvData := `Duelo,Fecha 1,,,,11/08 - 17/08,Link,,¿Se jugó?,¿Ganó Local?,¿Ganó Visita?
+1,herchu,0,0,Libre,,,,0,0,0
+2,webbi,0,0,BYE,,,,0,0,0
+3,alehrosario,0,0,,,,,0,0,0`
+
+	division, err := ParseDivision(csvData, WithByeSentinel("LIBRE"))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	matches := division.Rounds[0].Matches
+	if !matches[0].IsBye() {
+		t.Error("Expected the custom sentinel to mark a bye")
+	}
+	if matches[1].IsBye() {
+		t.Error("Expected the default sentinel to be a regular player once replaced")
+	}
+	if !matches[2].IsBye() {
+		t.Error("Expected an empty opponent to stay a bye with a custom sentinel")
+	}
+}
+
+func TestGetUnplayedMatches_SkipsByes(t *testing.T) {
+	csvData := `Duelo,Fecha 1,,,,11/08 - 17/08,Link,,¿Se jugó?,¿Ganó Local?,¿Ganó Visita?
+1,herchu,0,0,Lord Trooper,,,,0,0,0
+2,webbi,0,0,BYE,,,,0,0,0
+3,alehrosario,0,0,,,,,0,0,0`
+
+	division, err := ParseDivision(csvData)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	unplayed := GetUnplayedMatches(division)
+
+	if len(unplayed) != 1 || unplayed[0].ID != 1 {
+		t.Fatalf("Expected only match 1 unplayed, got %v", unplayed)
+	}
+}
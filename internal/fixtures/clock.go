@@ -0,0 +1,6 @@
+package fixtures
+
+import "time"
+
+// now returns the current time; tests replace it to freeze the clock
+var now = time.Now
@@ -0,0 +1,82 @@
+package fixtures
+
+import "fmt"
+
+// ColumnMapping gives the index of each match field in a fixture row, for spreadsheets laid out differently
+// ScoreDetail, HomeWon and AwayWon are optional; nil means the export has no such column
+// The won flags are only written when saving, the parser works them out from the scores
+type ColumnMapping struct {
+	ScoreDetail *int
+	HomeWon     *int
+	AwayWon     *int
+	ID          int
+	HomePlayer  int
+	HomeScore   int
+	AwayScore   int
+	AwayPlayer  int
+	DateTime    int
+	BGALink     int
+	Played      int
+}
+
+// DefaultColumnMapping is the layout the league's fixture spreadsheets export:
+// Duelo, home, home score, away score, away, datetime, link, blank, played, home won, away won, score detail
+var DefaultColumnMapping = ColumnMapping{
+	ID:          0,
+	HomePlayer:  1,
+	HomeScore:   2,
+	AwayScore:   3,
+	AwayPlayer:  4,
+	DateTime:    5,
+	BGALink:     6,
+	Played:      8,
+	HomeWon:     OptionalColumn(9),
+	AwayWon:     OptionalColumn(10),
+	ScoreDetail: OptionalColumn(scoreDetailColumn),
+}
+
+// OptionalColumn returns the index of an optional column such as ColumnMapping.ScoreDetail
+func OptionalColumn(index int) *int {
+	return &index
+}
+
+// requiredFields is how many fields a row needs to hold every mandatory column
+func (m ColumnMapping) requiredFields() int {
+	return max(m.ID, m.HomePlayer, m.HomeScore, m.AwayScore, m.AwayPlayer, m.DateTime, m.BGALink, m.Played) + 1
+}
+
+// mappedColumn names a column index of a ColumnMapping for validation errors
+type mappedColumn struct {
+	name  string
+	index int
+}
+
+// validate checks that every mapped column has a non-negative index of its own
+func (m ColumnMapping) validate() error {
+	columns := []mappedColumn{
+		{"Duelo", m.ID}, {"home player", m.HomePlayer}, {"home score", m.HomeScore},
+		{"away score", m.AwayScore}, {"away player", m.AwayPlayer}, {"datetime", m.DateTime},
+		{"link", m.BGALink}, {"played", m.Played},
+	}
+	for _, optional := range []struct {
+		index *int
+		name  string
+	}{{m.HomeWon, "home won"}, {m.AwayWon, "away won"}, {m.ScoreDetail, "score detail"}} {
+		if optional.index != nil {
+			columns = append(columns, mappedColumn{optional.name, *optional.index})
+		}
+	}
+
+	mapped := make(map[int]string, len(columns))
+	for _, column := range columns {
+		if column.index < 0 {
+			return fmt.Errorf("invalid column mapping: %s column %d is negative", column.name, column.index)
+		}
+		if other, taken := mapped[column.index]; taken {
+			return fmt.Errorf("invalid column mapping: %s and %s both read column %d", other, column.name, column.index)
+		}
+		mapped[column.index] = column.name
+	}
+
+	return nil
+}
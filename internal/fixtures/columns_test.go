@@ -0,0 +1,99 @@
+package fixtures
+
+import (
+	"reflect"
+	"testing"
+)
+
+// reorderedMapping reads rows laid out as: home, away, home score, away score, played, datetime, link, Duelo
+var reorderedMapping = ColumnMapping{
+	HomePlayer: 0,
+	AwayPlayer: 1,
+	HomeScore:  2,
+	AwayScore:  3,
+	Played:     4,
+	DateTime:   5,
+	BGALink:    6,
+	ID:         7,
+}
+
+func TestParseMatchWithMapping_ReorderedColumns(t *testing.T) {
+	line := "herchu,Lord Trooper,2,1,1,12/08 - 09:30,https://boardgamearena.com/tournament?id=423761,5"
+
+	match, err := ParseMatchWithMapping(line, reorderedMapping)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	expected := &Match{
+		ID:         5,
+		HomePlayer: "herchu",
+		AwayPlayer: "Lord Trooper",
+		HomeScore:  2,
+		AwayScore:  1,
+		DateTime:   "12/08 - 09:30",
+		BGALink:    "https://boardgamearena.com/tournament?id=423761",
+		Played:     true,
+	}
+
+	if !reflect.DeepEqual(match, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, match)
+	}
+}
+
+func TestParseMatchWithMapping_TooFewFields(t *testing.T) {
+	if _, err := ParseMatchWithMapping("herchu,Lord Trooper,2,1,1,12/08 - 09:30,", reorderedMapping); err == nil {
+		t.Error("Expected an error for a row missing the mapped Duelo column")
+	}
+}
+
+func TestParseDivision_WithMapping(t *testing.T) {
+	csvData := `Duelo,Fecha 1,,,,11/08 - 17/08,Link,,¿Se jugó?,¿Ganó Local?,¿Ganó Visita?
+herchu,Lord Trooper,2,1,1,12/08 - 09:30,https://boardgamearena.com/tournament?id=423761,1
+webbi,alehrosario,0,0,0,,,2`
+
+	division, err := ParseDivision(csvData, WithColumnMapping(reorderedMapping))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	matches := division.Rounds[0].Matches
+	if len(matches) != 2 || matches[1].ID != 2 || matches[1].HomePlayer != "webbi" || matches[1].Played {
+		t.Errorf("Expected both rows read through the mapping, got %+v", matches)
+	}
+
+	if _, err := ParseDivision(csvData); err == nil {
+		t.Error("Expected the default mapping to reject the reordered rows")
+	}
+}
+
+func TestParseMatchWithMapping_OmittedScoreDetailIsAbsent(t *testing.T) {
+	// The row is wide enough to reach column 0, which an omitted ScoreDetail must not read as game scores
+	line := "herchu,Lord Trooper,2,1,1,12/08 - 09:30,https://boardgamearena.com/tournament?id=423761,5"
+
+	match, err := ParseMatchWithMapping(line, reorderedMapping)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if match.GameScores != nil {
+		t.Errorf("Expected no game scores without a score detail column, got %v", match.GameScores)
+	}
+}
+
+func TestParseDivision_InvalidMapping(t *testing.T) {
+	csvData := `Duelo,Fecha 1,,,,11/08 - 17/08,Link,,¿Se jugó?,¿Ganó Local?,¿Ganó Visita?
+1,herchu,2,1,Lord Trooper,,,,1,1,0`
+
+	clashing := DefaultColumnMapping
+	clashing.ScoreDetail = OptionalColumn(clashing.ID)
+
+	negative := DefaultColumnMapping
+	negative.BGALink = -1
+
+	for name, mapping := range map[string]ColumnMapping{"clashing": clashing, "negative": negative} {
+		if _, err := ParseDivision(csvData, WithColumnMapping(mapping)); err == nil {
+			t.Errorf("Expected an error for the %s mapping", name)
+		}
+	}
+}
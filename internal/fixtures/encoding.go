@@ -0,0 +1,91 @@
+package fixtures
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding/charmap"
+)
+
+// utf8BOM is the byte order mark some spreadsheet exports put at the start of a UTF-8 file
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// fixtureEncoding is how a fixture file was encoded, so saving it writes the same encoding back
+type fixtureEncoding struct {
+	bom         bool // UTF-8 with a leading byte order mark
+	windows1252 bool // Not valid UTF-8, read as Windows-1252
+}
+
+// decodeFixture strips a leading UTF-8 BOM and transcodes fixtures that are not valid UTF-8 from Windows-1252
+// Windows-1252 is what spreadsheets on Windows export, e.g. "1° Temporada" written with a single 0xB0 byte
+func decodeFixture(data []byte) ([]byte, fixtureEncoding, error) {
+	var encoding fixtureEncoding
+
+	if bytes.HasPrefix(data, utf8BOM) {
+		encoding.bom = true
+		data = data[len(utf8BOM):]
+	}
+
+	if utf8.Valid(data) {
+		return data, encoding, nil
+	}
+
+	encoding.windows1252 = true
+	decoded, err := charmap.Windows1252.NewDecoder().Bytes(data)
+	if err != nil {
+		return nil, encoding, fmt.Errorf("failed to decode Windows-1252 fixture: %w", err)
+	}
+
+	return decoded, encoding, nil
+}
+
+// skipBOM discards a leading UTF-8 BOM, peeking at only the first bytes of r
+func skipBOM(r *bufio.Reader) error {
+	prefix, err := r.Peek(len(utf8BOM))
+	if err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+
+	if bytes.Equal(prefix, utf8BOM) {
+		_, err = r.Discard(len(utf8BOM))
+		return err
+	}
+
+	return nil
+}
+
+// decodeLine returns a fixture line as UTF-8, reading a line that is not valid UTF-8 as Windows-1252
+func decodeLine(line string) (string, error) {
+	if utf8.ValidString(line) {
+		return line, nil
+	}
+
+	decoded, err := charmap.Windows1252.NewDecoder().String(line)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode Windows-1252 fixture: %w", err)
+	}
+
+	return decoded, nil
+}
+
+// encode turns UTF-8 fixture contents back into the encoding the file was read with
+// Names that Windows-1252 cannot represent are an error rather than being replaced
+func (e fixtureEncoding) encode(data []byte) ([]byte, error) {
+	if e.windows1252 {
+		encoded, err := charmap.Windows1252.NewEncoder().Bytes(data)
+		if err != nil {
+			return nil, fmt.Errorf("fixture cannot be saved as Windows-1252: %w", err)
+		}
+		data = encoded
+	}
+
+	if e.bom {
+		data = append(append([]byte{}, utf8BOM...), data...)
+	}
+
+	return data, nil
+}
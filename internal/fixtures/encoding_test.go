@@ -0,0 +1,101 @@
+package fixtures
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+const encodedFixture = "Liga Argentina,1° Temporada,Élite\n" +
+	"Duelo,Fecha 1,,,,11/08 - 17/08,Link,,¿Se jugó?,¿Ganó Local?,¿Ganó Visita?\n" +
+	"1,Nicoooo95,2,1,Joaquín,12/08 - 22:25,https://boardgamearena.com/tournament?id=424020,,1,1,0\n"
+
+func TestParseDivisionReader_StripsUTF8BOM(t *testing.T) {
+	data := append([]byte{0xEF, 0xBB, 0xBF}, encodedFixture...)
+
+	division, err := ParseDivisionReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	assertEncodedFixture(t, division)
+}
+
+func TestParseDivisionReader_TranscodesWindows1252(t *testing.T) {
+	// "°", "É" and "í" are single bytes in Windows-1252, which is not valid UTF-8
+	data := bytes.NewBufferString("Liga Argentina,1\xb0 Temporada,\xc9lite\n" +
+		"Duelo,Fecha 1,,,,11/08 - 17/08,Link,,\xbfSe jug\xf3?,\xbfGan\xf3 Local?,\xbfGan\xf3 Visita?\n" +
+		"1,Nicoooo95,2,1,Joaqu\xedn,12/08 - 22:25,https://boardgamearena.com/tournament?id=424020,,1,1,0\n")
+
+	division, err := ParseDivisionReader(data)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	assertEncodedFixture(t, division)
+}
+
+func TestParseDivisionReader_Windows1252AfterLongASCIIPrefix(t *testing.T) {
+	var data strings.Builder
+	data.WriteString("Duelo,Fecha 1,,,,11/08 - 17/08,Link,,Se jugo?,Gano Local?,Gano Visita?\n")
+	for id := 1; id <= 200; id++ {
+		fmt.Fprintf(&data, "%d,herchu,0,0,webbi,,,,0,0,0\n", id)
+	}
+	data.WriteString("201,Nicoooo95,0,0,Joaqu\xedn,,,,0,0,0\n")
+
+	// The only Windows-1252 byte sits well past the first buffered bytes, read in one byte at a time
+	division, err := ParseDivisionReader(iotest.OneByteReader(strings.NewReader(data.String())))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	matches := division.Rounds[0].Matches
+	if got := matches[len(matches)-1].AwayPlayer; got != "Joaquín" {
+		t.Errorf("Expected the late Windows-1252 name Joaquín, got %q", got)
+	}
+}
+
+func TestParseFixtureFile_Windows1252KeepsFilenameDivision(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "Liga Argentina - 1° Temporada - Oro-Fixture.csv")
+	data := "Duelo,Fecha 1,,,,11/08 - 17/08,Link,,\xbfSe jug\xf3?,\xbfGan\xf3 Local?,\xbfGan\xf3 Visita?\n" +
+		"1,Nicoooo95,0,0,Joaqu\xedn,,,,0,0,0\n"
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatalf("Failed to write fixture: %v", err)
+	}
+
+	division, err := ParseFixtureFile(path)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if division.Name != "Oro" {
+		t.Errorf("Expected division Oro from the filename, got %q", division.Name)
+	}
+	if got := division.Rounds[0].Matches[0].AwayPlayer; got != "Joaquín" {
+		t.Errorf("Expected away player Joaquín, got %q", got)
+	}
+}
+
+// assertEncodedFixture checks the division parsed from encodedFixture, whatever its encoding
+func assertEncodedFixture(t *testing.T, division *Division) {
+	t.Helper()
+
+	if division.Name != "Élite" || division.Season != 1 {
+		t.Errorf("Expected division Élite of season 1, got %q of season %d", division.Name, division.Season)
+	}
+	if len(division.Rounds) != 1 || len(division.Rounds[0].Matches) != 1 {
+		t.Fatalf("Expected one round with one match, got %+v", division.Rounds)
+	}
+
+	match := division.Rounds[0].Matches[0]
+	if match.HomePlayer != "Nicoooo95" || match.AwayPlayer != "Joaquín" {
+		t.Errorf("Expected Nicoooo95 vs Joaquín, got %q vs %q", match.HomePlayer, match.AwayPlayer)
+	}
+	if !match.Played || match.HomeScore != 2 || match.AwayScore != 1 {
+		t.Errorf("Expected a played 2-1, got %+v", match)
+	}
+}
@@ -0,0 +1,70 @@
+package fixtures
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ForfeitScore is the score recorded for a player who forfeited the match
+// Fixtures exported with another sentinel are read with WithForfeitScore
+const ForfeitScore = -1
+
+// ForfeitMarker is how a forfeit is written in fixture CSVs and shown on screen
+const ForfeitMarker = "F"
+
+// parseScore parses a fixture score, reading the forfeit marker or the export's forfeit sentinel as ForfeitScore
+func parseScore(value string, forfeitScore int) (int, error) {
+	value = strings.TrimSpace(value)
+	if strings.EqualFold(value, ForfeitMarker) {
+		return ForfeitScore, nil
+	}
+
+	score, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, err
+	}
+
+	if score == forfeitScore {
+		return ForfeitScore, nil
+	}
+
+	if score < 0 {
+		return 0, fmt.Errorf("negative score %d", score)
+	}
+
+	return score, nil
+}
+
+// FormatScore renders a score for display, showing the forfeit marker instead of the sentinel
+func FormatScore(score int) string {
+	if score == ForfeitScore {
+		return ForfeitMarker
+	}
+	return strconv.Itoa(score)
+}
+
+// HomeForfeited reports whether the home player forfeited the match
+func (m *Match) HomeForfeited() bool {
+	return m.HomeScore == ForfeitScore
+}
+
+// AwayForfeited reports whether the away player forfeited the match
+func (m *Match) AwayForfeited() bool {
+	return m.AwayScore == ForfeitScore
+}
+
+// outcome returns "W", "L" or "D" for a player given both scores
+// A forfeiting player always loses; when both sides forfeited neither gets the win
+func outcome(own, opponent int) string {
+	switch {
+	case own == ForfeitScore:
+		return "L"
+	case opponent == ForfeitScore, own > opponent:
+		return "W"
+	case own < opponent:
+		return "L"
+	default:
+		return "D"
+	}
+}
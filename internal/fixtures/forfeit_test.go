@@ -0,0 +1,91 @@
+package fixtures
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseMatch_ForfeitMarker(t *testing.T) {
+	match, err := ParseMatch("7,herchu,2,F,Lord Trooper,12/08 - 09:30,,,1,1,0")
+	if err != nil {
+		t.Fatalf("Expected forfeit row to parse, got: %v", err)
+	}
+
+	if match.HomeScore != 2 || match.AwayScore != ForfeitScore {
+		t.Errorf("Expected 2-%d, got %d-%d", ForfeitScore, match.HomeScore, match.AwayScore)
+	}
+
+	if !match.AwayForfeited() || match.HomeForfeited() {
+		t.Error("Expected only the away player to have forfeited")
+	}
+}
+
+func TestParseMatch_ForfeitSentinel(t *testing.T) {
+	match, err := ParseMatch("7,herchu,-1,0,Lord Trooper,12/08 - 09:30,,,1,1,0")
+	if err != nil {
+		t.Fatalf("Expected sentinel score to parse, got: %v", err)
+	}
+
+	if !match.HomeForfeited() {
+		t.Error("Expected the home player to have forfeited")
+	}
+
+	if _, err := ParseMatch("7,herchu,-2,0,Lord Trooper,12/08 - 09:30,,,1,1,0"); err == nil {
+		t.Error("Expected a negative score other than the sentinel to be rejected")
+	}
+}
+
+func TestParseMatch_ConfigurableForfeitSentinel(t *testing.T) {
+	header := "Duelo,Fecha 1,,,,11/08 - 17/08,Link,,¿Se jugó?,¿Ganó Local?,¿Ganó Visita?\n"
+
+	division, err := ParseDivision(header+"7,herchu,0,-99,Lord Trooper,12/08 - 09:30,,,1,1,0", WithForfeitScore(-99))
+	if err != nil {
+		t.Fatalf("Expected configured sentinel to parse, got: %v", err)
+	}
+
+	match := division.Rounds[0].Matches[0]
+	if !match.AwayForfeited() || match.AwayScore != ForfeitScore || !match.Walkover {
+		t.Errorf("Expected the configured sentinel read as a forfeit, got %+v", match)
+	}
+
+	defaultSentinel := header + "7,herchu,0,-1,Lord Trooper,12/08 - 09:30,,,1,1,0"
+	if _, err := ParseDivision(defaultSentinel, WithForfeitScore(-99)); err == nil {
+		t.Error("Expected the default sentinel to be rejected once another one is configured")
+	}
+
+	if _, err := ParseDivision(header + "7,herchu,0,-99,Lord Trooper,12/08 - 09:30,,,1,1,0"); err == nil {
+		t.Error("Expected the configured sentinel to stay local to the parse it was passed to")
+	}
+}
+
+func TestRecentForm_Forfeits(t *testing.T) {
+	division := &Division{
+		Name: "Elite",
+		Rounds: []*Round{
+			{Number: 1, Matches: []*Match{
+				{ID: 1, HomePlayer: "herchu", HomeScore: ForfeitScore, AwayScore: 0, AwayPlayer: "webbi", Played: true},
+			}},
+			{Number: 2, Matches: []*Match{
+				{ID: 2, HomePlayer: "Lord Trooper", HomeScore: ForfeitScore, AwayScore: 0, AwayPlayer: "herchu", Played: true},
+			}},
+		},
+	}
+
+	if form := strings.Join(RecentForm(division, "herchu", 5), ""); form != "LW" {
+		t.Errorf("Expected forfeits to give 'LW', got %q", form)
+	}
+
+	if form := strings.Join(RecentForm(division, "webbi", 5), ""); form != "W" {
+		t.Errorf("Expected opponent of a forfeit to win, got %q", form)
+	}
+}
+
+func TestFormatScore(t *testing.T) {
+	if got := FormatScore(2); got != "2" {
+		t.Errorf("Expected '2', got %q", got)
+	}
+
+	if got := FormatScore(ForfeitScore); got != ForfeitMarker {
+		t.Errorf("Expected forfeit to display as %q, got %q", ForfeitMarker, got)
+	}
+}
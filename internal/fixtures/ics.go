@@ -0,0 +1,72 @@
+package fixtures
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// icsEventDuration is how long each exported match lasts in the calendar
+const icsEventDuration = 30 * time.Minute
+
+// icsTimeLayout writes event times in UTC the way iCalendar expects, e.g. 20250812T123000Z
+const icsTimeLayout = "20060102T150405Z"
+
+// icsEscaper escapes the characters iCalendar text values reserve
+var icsEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`)
+
+// ExportICS writes the division's matches with an agreed date and time to w as an iCalendar file
+// Each match is a 30-minute event titled "Duelo N: home vs away" with its BGA link as the description
+// Datetimes are read in the local timezone like ScheduledTime; undated matches and byes are skipped
+func ExportICS(d *Division, w io.Writer) error {
+	current := now()
+	lines := []string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//carca-cli//Fixture export//EN",
+		"CALSCALE:GREGORIAN",
+		"X-WR-CALNAME:" + icsEscaper.Replace("Division "+d.Name),
+	}
+
+	for _, round := range d.Rounds {
+		for _, match := range round.Matches {
+			start, err := match.ScheduledTime(current)
+			if err != nil || match.IsBye() {
+				continue
+			}
+
+			lines = append(lines, icsEvent(d.Name, round.Number, match, start, current)...)
+		}
+	}
+
+	lines = append(lines, "END:VCALENDAR")
+
+	if _, err := io.WriteString(w, strings.Join(lines, "\r\n")+"\r\n"); err != nil {
+		return fmt.Errorf("failed to write calendar: %w", err)
+	}
+
+	return nil
+}
+
+// icsEvent renders the VEVENT of a match starting at start, stamped with the export time
+// The UID includes the round since Duelo numbers may repeat within a division
+func icsEvent(division string, roundNumber int, match *Match, start, stamp time.Time) []string {
+	uid := fmt.Sprintf("%s-r%d-duelo-%d@carca-cli", strings.ReplaceAll(strings.ToLower(division), " ", "-"),
+		roundNumber, match.ID)
+	summary := fmt.Sprintf("Duelo %d: %s vs %s", match.ID, match.HomePlayer, match.AwayPlayer)
+
+	event := []string{
+		"BEGIN:VEVENT",
+		"UID:" + uid,
+		"DTSTAMP:" + stamp.UTC().Format(icsTimeLayout),
+		"DTSTART:" + start.UTC().Format(icsTimeLayout),
+		"DTEND:" + start.Add(icsEventDuration).UTC().Format(icsTimeLayout),
+		"SUMMARY:" + icsEscaper.Replace(summary),
+	}
+	if match.BGALink != "" {
+		event = append(event, "DESCRIPTION:"+icsEscaper.Replace(match.BGALink))
+	}
+
+	return append(event, "END:VEVENT")
+}
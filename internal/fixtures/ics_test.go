@@ -0,0 +1,112 @@
+package fixtures
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// freezeClock makes now return at for the rest of the test
+func freezeClock(t *testing.T, at time.Time) {
+	t.Helper()
+
+	original := now
+	now = func() time.Time { return at }
+	t.Cleanup(func() { now = original })
+}
+
+func TestExportICS(t *testing.T) {
+	freezeClock(t, time.Date(2025, 8, 10, 12, 0, 0, 0, time.UTC))
+
+	division := &Division{
+		Name: "Oro A",
+		Rounds: []*Round{
+			{Number: 1, Matches: []*Match{
+				{ID: 1, HomePlayer: "herchu", AwayPlayer: "Lord Trooper", DateTime: "12/08 - 21:30",
+					BGALink: "https://boardgamearena.com/tournament?id=423761"},
+				{ID: 2, HomePlayer: "webbi", AwayPlayer: "alehrosario"},
+				{ID: 3, HomePlayer: "Nicoooo95", AwayPlayer: "BYE", DateTime: "13/08 - 21:00"},
+			}},
+		},
+	}
+
+	var out strings.Builder
+	if err := ExportICS(division, &out); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	ics := out.String()
+
+	for _, want := range []string{
+		"BEGIN:VCALENDAR\r\n",
+		"UID:oro-a-r1-duelo-1@carca-cli\r\n",
+		"DTSTART:20250812T213000Z\r\n",
+		"DTEND:20250812T220000Z\r\n",
+		"SUMMARY:Duelo 1: herchu vs Lord Trooper\r\n",
+		"DESCRIPTION:https://boardgamearena.com/tournament?id=423761\r\n",
+		"END:VCALENDAR\r\n",
+	} {
+		if !strings.Contains(ics, want) {
+			t.Errorf("Expected %q in the calendar, got:\n%s", want, ics)
+		}
+	}
+
+	if count := strings.Count(ics, "BEGIN:VEVENT"); count != 1 {
+		t.Errorf("Expected only the dated match exported, got %d events:\n%s", count, ics)
+	}
+	if strings.Contains(ics, "Duelo 2") || strings.Contains(ics, "Duelo 3") {
+		t.Errorf("Expected undated matches and byes skipped, got:\n%s", ics)
+	}
+}
+
+func TestExportICS_DecemberMatchExportedInJanuary(t *testing.T) {
+	freezeClock(t, time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC))
+
+	division := &Division{
+		Name: "Oro A",
+		Rounds: []*Round{
+			{Number: 1, Matches: []*Match{
+				{ID: 1, HomePlayer: "herchu", AwayPlayer: "Lord Trooper", DateTime: "20/12 - 21:00"},
+			}},
+		},
+	}
+
+	var out strings.Builder
+	if err := ExportICS(division, &out); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if ics := out.String(); !strings.Contains(ics, "DTSTART:20251220T210000Z\r\n") {
+		t.Errorf("Expected the December match in the previous year, got:\n%s", ics)
+	}
+}
+
+func TestExportICS_EscapesText(t *testing.T) {
+	freezeClock(t, time.Date(2025, 8, 10, 12, 0, 0, 0, time.UTC))
+
+	division := &Division{Name: "Elite", Rounds: []*Round{
+		{Number: 2, Matches: []*Match{{ID: 4, HomePlayer: "a,b", AwayPlayer: "c;d", DateTime: "12/08 - 21:30"}}},
+	}}
+
+	var out strings.Builder
+	if err := ExportICS(division, &out); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if !strings.Contains(out.String(), `SUMMARY:Duelo 4: a\,b vs c\;d`) {
+		t.Errorf("Expected commas and semicolons escaped, got:\n%s", out.String())
+	}
+}
+
+// failingWriter rejects every write
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("disk full")
+}
+
+func TestExportICS_WriteError(t *testing.T) {
+	if err := ExportICS(&Division{Name: "Elite"}, failingWriter{}); err == nil {
+		t.Error("Expected the write error to be returned")
+	}
+}
@@ -0,0 +1,28 @@
+package fixtures
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// MarshalDivisionJSON encodes a division as indented JSON for backups and other tools
+// The local fixture filename is left out since it only makes sense on this machine
+func MarshalDivisionJSON(d *Division) ([]byte, error) {
+	data, err := json.MarshalIndent(d, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode division %s: %w", d.Name, err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalDivisionJSON decodes a division written by MarshalDivisionJSON
+// A missing season defaults to the first one, like a CSV fixture without metadata
+func UnmarshalDivisionJSON(data []byte) (*Division, error) {
+	division := &Division{Season: DefaultSeason}
+	if err := json.Unmarshal(data, division); err != nil {
+		return nil, fmt.Errorf("failed to decode division: %w", err)
+	}
+
+	return division, nil
+}
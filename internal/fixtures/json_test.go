@@ -0,0 +1,57 @@
+package fixtures
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDivisionJSON_RoundTrip(t *testing.T) {
+	csvData := "Duelo,Fecha 1,,,,11/08 - 17/08,Link,,¿Se jugó?,¿Ganó Local?,¿Ganó Visita?\n" +
+		"1,herchu,2,1,Lord Trooper,12/08 - 09:30,https://boardgamearena.com/tournament?id=423761,,1,1,0\n" +
+		"2,webbi,0,F,alehrosario,-,,,1,1,0\n" +
+		",,,,,,,,,,\n" +
+		"Duelo,Fecha 2,,,,18/08 - 24/08,Link,,¿Se jugó?,¿Ganó Local?,¿Ganó Visita?\n" +
+		"3,Lord Trooper,0,0,webbi,21/08 - 16:00,,,0,0,0\n"
+
+	division, err := ParseDivision(csvData)
+	if err != nil {
+		t.Fatalf("Failed to parse division: %v", err)
+	}
+	division.Name = "Elite"
+
+	data, err := MarshalDivisionJSON(division)
+	if err != nil {
+		t.Fatalf("Failed to marshal division: %v", err)
+	}
+
+	for _, field := range []string{`"played": true`, `"bga_link": "https://boardgamearena.com/tournament?id=423761"`} {
+		if !strings.Contains(string(data), field) {
+			t.Errorf("Expected JSON to contain %s, got:\n%s", field, data)
+		}
+	}
+
+	decoded, err := UnmarshalDivisionJSON(data)
+	if err != nil {
+		t.Fatalf("Failed to unmarshal division: %v", err)
+	}
+
+	if !reflect.DeepEqual(decoded, division) {
+		t.Errorf("Expected round trip to preserve the division\noriginal: %+v\ndecoded:  %+v", division, decoded)
+	}
+}
+
+func TestUnmarshalDivisionJSON(t *testing.T) {
+	division, err := UnmarshalDivisionJSON([]byte(`{"name":"Elite","rounds":[]}`))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if division.Season != DefaultSeason {
+		t.Errorf("Expected a missing season to default to %d, got %d", DefaultSeason, division.Season)
+	}
+
+	if _, err := UnmarshalDivisionJSON([]byte("not json")); err == nil {
+		t.Error("Expected an error for invalid JSON")
+	}
+}
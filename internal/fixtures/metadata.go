@@ -0,0 +1,49 @@
+package fixtures
+
+import (
+	"encoding/csv"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// DefaultSeason is the season assumed for fixtures without a metadata header
+const DefaultSeason = 1
+
+// seasonPattern matches a season field such as "1° Temporada" or "2da Temporada"
+var seasonPattern = regexp.MustCompile(`(?i)^(\d+)\s*(?:°|º|era|ra|da|ta|ma|va|na)?\s*temporada$`)
+
+// parseMetadataHeader reads a "League, 1° Temporada, Division" row into the division
+// It reports false, leaving the division untouched, when the line is not such a row
+func parseMetadataHeader(line string, division *Division) bool {
+	fields, err := csv.NewReader(strings.NewReader(line)).Read()
+	if err != nil {
+		return false
+	}
+
+	seasonIndex := -1
+	season := 0
+
+	for i, field := range fields {
+		if match := seasonPattern.FindStringSubmatch(strings.TrimSpace(field)); match != nil {
+			season, _ = strconv.Atoi(match[1])
+			seasonIndex = i
+			break
+		}
+	}
+
+	if seasonIndex < 0 {
+		return false
+	}
+
+	division.Season = season
+
+	// The division name is the last non-empty field after the season
+	for _, field := range fields[seasonIndex+1:] {
+		if name := strings.TrimSpace(field); name != "" {
+			division.Name = name
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,70 @@
+package fixtures
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const fixtureRound = `Duelo,Fecha 1,,,,11/08 - 17/08,Detalles,,¿Se jugó?,¿Ganó Local?,¿Ganó Visita?,,,
+1,herchu,2,1,Lord Trooper,12/08 - 09:30,https://boardgamearena.com/tournament?id=423761,,1,1,0,,,
+`
+
+func TestParseDivision_MetadataHeader(t *testing.T) {
+	division, err := ParseDivision("Liga Argentina, 2° Temporada, Elite,,,,,,,,,,,\n" + fixtureRound)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if division.Name != "Elite" {
+		t.Errorf("Expected name 'Elite' from the metadata header, got %q", division.Name)
+	}
+
+	if division.Season != 2 {
+		t.Errorf("Expected season 2 from the metadata header, got %d", division.Season)
+	}
+
+	if len(division.Rounds) != 1 || len(division.Rounds[0].Matches) != 1 {
+		t.Errorf("Expected the round below the header to parse, got %d rounds", len(division.Rounds))
+	}
+}
+
+func TestParseDivision_WithoutMetadataHeader(t *testing.T) {
+	division, err := ParseDivision(fixtureRound)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if division.Name != "" || division.Season != DefaultSeason {
+		t.Errorf("Expected no name and the default season without a header, got %q season %d",
+			division.Name, division.Season)
+	}
+}
+
+func TestParseFixtureFile_MetadataHeaderOverridesFilename(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "Liga Argentina - 1° Temporada - E-Fixture.csv")
+	data := "Liga Argentina,3era Temporada,Platinum A\n" + fixtureRound
+	if err := os.WriteFile(filename, []byte(data), 0o600); err != nil {
+		t.Fatalf("Failed to write fixture: %v", err)
+	}
+
+	division, err := ParseFixtureFile(filename)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if division.Name != "Platinum A" || division.Season != 3 {
+		t.Errorf("Expected 'Platinum A' season 3 from the header, got %q season %d", division.Name, division.Season)
+	}
+}
+
+func TestParseMetadataHeader_IgnoresOtherRows(t *testing.T) {
+	division := &Division{}
+	if parseMetadataHeader("Notas del torneo,,,,", division) {
+		t.Error("Expected a row without a season not to be treated as metadata")
+	}
+
+	if division.Name != "" || division.Season != 0 {
+		t.Errorf("Expected division untouched, got %q season %d", division.Name, division.Season)
+	}
+}
@@ -0,0 +1,52 @@
+package fixtures
+
+// ParseOption adjusts how a fixture export is read
+type ParseOption func(*parseConfig)
+
+// parseConfig is how the rows of a fixture export are laid out and scored
+type parseConfig struct {
+	columns       ColumnMapping
+	byeSentinel   string // Player name the export writes for a bye, DefaultByeSentinel unless set
+	forfeitScore  int    // Score the export writes for a forfeit besides ForfeitMarker
+	customColumns bool   // Columns came from WithColumnMapping, so the division keeps them for saving
+}
+
+// WithColumnMapping reads match rows laid out as mapping instead of DefaultColumnMapping
+// The parsed division is written back to its file in the same layout
+func WithColumnMapping(mapping ColumnMapping) ParseOption {
+	return func(config *parseConfig) {
+		config.columns = mapping
+		config.customColumns = true
+	}
+}
+
+// WithForfeitScore reads score as a forfeit, for exports using another negative sentinel than ForfeitScore
+// Forfeits are stored as ForfeitScore whatever the export wrote
+func WithForfeitScore(score int) ParseOption {
+	return func(config *parseConfig) {
+		config.forfeitScore = score
+	}
+}
+
+// WithByeSentinel reads name instead of DefaultByeSentinel as a player sitting out the round
+// An empty opponent is a bye whatever the sentinel
+func WithByeSentinel(name string) ParseOption {
+	return func(config *parseConfig) {
+		config.byeSentinel = name
+	}
+}
+
+// newParseConfig applies opts over the default layout and forfeit sentinel
+func newParseConfig(opts []ParseOption) parseConfig {
+	config := parseConfig{
+		columns:      DefaultColumnMapping,
+		byeSentinel:  DefaultByeSentinel,
+		forfeitScore: ForfeitScore,
+	}
+
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	return config
+}
@@ -1,8 +1,10 @@
 package fixtures
 
 import (
+	"bufio"
 	"encoding/csv"
 	"fmt"
+	"io"
 	"os"
 	"strconv"
 	"strings"
@@ -10,31 +12,68 @@ import (
 
 // Division represents a complete tournament division with all rounds
 type Division struct {
-	Name   string
-	Rounds []*Round
+	Columns  *ColumnMapping `json:"-"` // Layout the fixture file was read with, nil for DefaultColumnMapping
+	Name     string         `json:"name"`
+	Filename string         `json:"-"` // Fixture file the division was read from, empty when parsed from memory
+	Rounds   []*Round       `json:"rounds"`
+	Season   int            `json:"season"` // Season from the metadata header, DefaultSeason when the fixture has none
 }
 
 // Round represents a tournament round with multiple matches
 type Round struct {
-	DateRange string
-	Matches   []*Match
-	Number    int
+	DateRange string   `json:"date_range"`
+	Matches   []*Match `json:"matches"`
+	Number    int      `json:"number"`
 }
 
 // Match represents a tournament match between two players
 type Match struct {
-	HomePlayer string
-	AwayPlayer string
-	DateTime   string
-	BGALink    string
-	ID         int
-	HomeScore  int
-	AwayScore  int
-	Played     bool
+	GameScores  []string `json:"game_scores,omitempty"` // Per-game results like "75-60" from the optional detail column
+	HomePlayer  string   `json:"home_player"`
+	AwayPlayer  string   `json:"away_player"`
+	DateTime    string   `json:"datetime"`
+	BGALink     string   `json:"bga_link"`
+	byeSentinel string   // Bye placeholder the match was parsed with, empty for DefaultByeSentinel
+	ID          int      `json:"id"`
+	HomeScore   int      `json:"home_score"`
+	AwayScore   int      `json:"away_score"`
+	Played      bool     `json:"played"`
+	Walkover    bool     `json:"walkover"` // Won by a no-show; the absent player is scored as a forfeit
 }
 
-// ParseMatch parses a CSV line into a Match struct
+// scoreDetailColumn is the optional column after the won flags holding per-game results
+const scoreDetailColumn = 11
+
+// parseGameScores splits a score detail like "75-60, 55-70, 80-50", nil when the column is empty
+func parseGameScores(detail string) []string {
+	var scores []string
+
+	for _, score := range strings.Split(detail, ",") {
+		if score = strings.TrimSpace(score); score != "" {
+			scores = append(scores, score)
+		}
+	}
+
+	return scores
+}
+
+// ParseMatch parses a CSV line laid out as DefaultColumnMapping into a Match struct
 func ParseMatch(csvLine string) (*Match, error) {
+	return ParseMatchWithMapping(csvLine, DefaultColumnMapping)
+}
+
+// ParseMatchWithMapping parses a CSV line into a Match struct, reading each field from the mapped column
+func ParseMatchWithMapping(csvLine string, mapping ColumnMapping) (*Match, error) {
+	if err := mapping.validate(); err != nil {
+		return nil, err
+	}
+
+	return parseMatch(csvLine, parseConfig{columns: mapping, forfeitScore: ForfeitScore})
+}
+
+// parseMatch parses a CSV line laid out and scored as config into a Match struct
+func parseMatch(csvLine string, config parseConfig) (*Match, error) {
+	mapping := config.columns
 	reader := csv.NewReader(strings.NewReader(csvLine))
 
 	records, err := reader.Read()
@@ -42,43 +81,59 @@ func ParseMatch(csvLine string) (*Match, error) {
 		return nil, fmt.Errorf("failed to parse CSV line: %w", err)
 	}
 
-	if len(records) < 10 {
-		return nil, fmt.Errorf("invalid CSV format: expected at least 10 fields, got %d", len(records))
+	if required := mapping.requiredFields(); len(records) < required {
+		return nil, fmt.Errorf("invalid CSV format: expected at least %d fields, got %d", required, len(records))
 	}
 
-	id, err := strconv.Atoi(records[0])
+	id, err := strconv.Atoi(records[mapping.ID])
 	if err != nil {
 		return nil, fmt.Errorf("invalid match ID: %w", err)
 	}
 
-	homeScore, err := strconv.Atoi(records[2])
+	homeScore, err := parseScore(records[mapping.HomeScore], config.forfeitScore)
 	if err != nil {
 		return nil, fmt.Errorf("invalid home score: %w", err)
 	}
 
-	awayScore, err := strconv.Atoi(records[3])
+	awayScore, err := parseScore(records[mapping.AwayScore], config.forfeitScore)
 	if err != nil {
 		return nil, fmt.Errorf("invalid away score: %w", err)
 	}
 
-	played := records[8] == "1"
+	var gameScores []string
+	if mapping.ScoreDetail != nil && len(records) > *mapping.ScoreDetail {
+		gameScores = parseGameScores(records[*mapping.ScoreDetail])
+	}
+
+	played := records[mapping.Played] == "1"
+	walkover := played && (homeScore == ForfeitScore) != (awayScore == ForfeitScore)
 
 	match := &Match{
 		ID:         id,
-		HomePlayer: records[1],
+		HomePlayer: records[mapping.HomePlayer],
 		HomeScore:  homeScore,
 		AwayScore:  awayScore,
-		AwayPlayer: records[4],
-		DateTime:   records[5],
-		BGALink:    records[6],
+		AwayPlayer: records[mapping.AwayPlayer],
+		DateTime:   records[mapping.DateTime],
+		BGALink:    records[mapping.BGALink],
 		Played:     played,
+		Walkover:   walkover,
+		GameScores: gameScores,
+	}
+	if config.byeSentinel != DefaultByeSentinel {
+		match.byeSentinel = config.byeSentinel
 	}
 
 	return match, nil
 }
 
-// ParseRound parses CSV data containing a round header and matches
+// ParseRound parses CSV data containing a round header and matches laid out as DefaultColumnMapping
 func ParseRound(csvData string) (*Round, error) {
+	return parseRound(csvData, newParseConfig(nil))
+}
+
+// parseRound parses CSV data containing a round header and matches laid out and scored as config
+func parseRound(csvData string, config parseConfig) (*Round, error) {
 	lines := strings.Split(csvData, "\n")
 	if len(lines) < 2 {
 		return nil, fmt.Errorf("invalid round data: need at least header and one match")
@@ -120,7 +175,7 @@ func ParseRound(csvData string) (*Round, error) {
 			continue
 		}
 
-		match, err := ParseMatch(line)
+		match, err := parseMatch(line, config)
 		if err != nil {
 			return nil, fmt.Errorf("failed to parse match on line %d: %w", i+1, err)
 		}
@@ -132,24 +187,56 @@ func ParseRound(csvData string) (*Round, error) {
 }
 
 // ParseDivision parses complete CSV data containing multiple rounds separated by empty lines
-func ParseDivision(csvData string) (*Division, error) {
-	lines := strings.Split(csvData, "\n")
+// Match rows follow DefaultColumnMapping and forfeits ForfeitScore unless opts say otherwise
+func ParseDivision(csvData string, opts ...ParseOption) (*Division, error) {
+	return ParseDivisionReader(strings.NewReader(csvData), opts...)
+}
+
+// ParseDivisionReader parses a fixture line by line from r, e.g. embedded data or a download
+// UTF-8 with or without a BOM and Windows-1252 exports are both accepted, decoded a line at a time
+// Match rows follow DefaultColumnMapping and forfeits ForfeitScore unless opts say otherwise
+func ParseDivisionReader(r io.Reader, opts ...ParseOption) (*Division, error) {
+	config := newParseConfig(opts)
+	if err := config.columns.validate(); err != nil {
+		return nil, err
+	}
+
+	reader := bufio.NewReader(r)
+	if err := skipBOM(reader); err != nil {
+		return nil, fmt.Errorf("failed to read fixture: %w", err)
+	}
+
+	scanner := bufio.NewScanner(reader)
 	division := &Division{
 		Rounds: make([]*Round, 0),
+		Season: DefaultSeason,
+	}
+	if config.customColumns {
+		division.Columns = &config.columns
 	}
 
 	var currentRoundLines []string
 
-	for _, line := range lines {
+	for scanner.Scan() {
+		line, err := decodeLine(scanner.Text())
+		if err != nil {
+			return nil, err
+		}
 		line = strings.TrimSpace(line)
 
+		// An optional metadata row above the first round names the division and season
+		if len(division.Rounds) == 0 && len(currentRoundLines) == 0 && line != "" &&
+			!strings.HasPrefix(line, "Duelo,Fecha") && parseMetadataHeader(line, division) {
+			continue
+		}
+
 		// Check if this line starts a new round (header line)
 		if strings.HasPrefix(line, "Duelo,Fecha") {
 			// If we have accumulated lines for a previous round, process them
 			if len(currentRoundLines) > 0 {
 				roundData := strings.Join(currentRoundLines, "\n")
 
-				round, err := ParseRound(roundData)
+				round, err := parseRound(roundData, config)
 				if err != nil {
 					return nil, fmt.Errorf("failed to parse round: %w", err)
 				}
@@ -168,11 +255,15 @@ func ParseDivision(csvData string) (*Division, error) {
 		}
 	}
 
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read fixture: %w", err)
+	}
+
 	// Process the last round if it exists
 	if len(currentRoundLines) > 0 {
 		roundData := strings.Join(currentRoundLines, "\n")
 
-		round, err := ParseRound(roundData)
+		round, err := parseRound(roundData, config)
 		if err != nil {
 			return nil, fmt.Errorf("failed to parse final round: %w", err)
 		}
@@ -183,40 +274,65 @@ func ParseDivision(csvData string) (*Division, error) {
 	return division, nil
 }
 
-// ParseFixtureFile reads a CSV file and parses it into a Division
-func ParseFixtureFile(filename string) (*Division, error) {
-	data, err := os.ReadFile(filename)
+// columnMapping returns the layout the division's fixture file is written in
+func (d *Division) columnMapping() ColumnMapping {
+	if d.Columns == nil {
+		return DefaultColumnMapping
+	}
+
+	return *d.Columns
+}
+
+// ParseFixtureFile reads a CSV file and parses it into a Division, applying opts like ParseDivisionReader
+func ParseFixtureFile(filename string, opts ...ParseOption) (*Division, error) {
+	file, err := os.Open(filename)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read fixture file %s: %w", filename, err)
 	}
+	defer file.Close()
 
-	division, err := ParseDivision(string(data))
+	division, err := ParseDivisionReader(file, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse fixture file %s: %w", filename, err)
 	}
 
-	// Extract division name from filename
-	// e.g., "Liga Argentina - 1° Temporada - E-Fixture.csv" -> "E"
-	if strings.Contains(filename, " - ") && strings.Contains(filename, "-Fixture.csv") {
-		parts := strings.Split(filename, " - ")
-		if len(parts) >= 3 {
-			namePart := parts[len(parts)-1]
-			if strings.HasSuffix(namePart, "-Fixture.csv") {
-				division.Name = strings.TrimSuffix(namePart, "-Fixture.csv")
-			}
-		}
+	division.Filename = filename
+
+	// Extract division name from filename unless the metadata header named it
+	if division.Name == "" {
+		division.Name = DivisionNameFromFilename(filename)
 	}
 
 	return division, nil
 }
 
+// DivisionNameFromFilename derives a division name from its fixture file name, or "" when it has no name part
+// e.g., "Liga Argentina - 1° Temporada - E-Fixture.csv" -> "E"
+func DivisionNameFromFilename(filename string) string {
+	if !strings.Contains(filename, " - ") || !strings.Contains(filename, "-Fixture.csv") {
+		return ""
+	}
+
+	parts := strings.Split(filename, " - ")
+	if len(parts) < 3 {
+		return ""
+	}
+
+	namePart := parts[len(parts)-1]
+	if !strings.HasSuffix(namePart, "-Fixture.csv") {
+		return ""
+	}
+
+	return strings.TrimSuffix(namePart, "-Fixture.csv")
+}
+
 // GetUnplayedMatches returns all matches from a division that haven't been played yet
 func GetUnplayedMatches(division *Division) []*Match {
 	var unplayed []*Match
 
 	for _, round := range division.Rounds {
 		for _, match := range round.Matches {
-			if !match.Played {
+			if !match.Played && !match.IsBye() {
 				unplayed = append(unplayed, match)
 			}
 		}
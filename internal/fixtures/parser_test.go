@@ -1,6 +1,7 @@
 package fixtures
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -66,6 +67,28 @@ func TestParseMatch_UnplayedMatch(t *testing.T) {
 	}
 }
 
+func TestParseMatch_GameScores(t *testing.T) {
+	match, err := ParseMatch(`1,herchu,2,1,Lord Trooper,12/08 - 09:30,,,1,1,0,"75-60, 55-70, 80-50",,`)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if got := strings.Join(match.GameScores, "|"); got != "75-60|55-70|80-50" {
+		t.Errorf("Expected three game scores, got %q", got)
+	}
+
+	for _, line := range []string{"2,webbi,2,0,alehrosario,,,,1,1,0,,,", "3,webbi,2,0,alehrosario,,,,1,1,0"} {
+		match, err := ParseMatch(line)
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+
+		if match.GameScores != nil {
+			t.Errorf("Expected no game scores for %q, got %v", line, match.GameScores)
+		}
+	}
+}
+
 func TestParseRound_ValidRound(t *testing.T) {
 	csvData := `Duelo,Fecha 1,,,,11/08 - 17/08,Link,,¿Se jugó?,¿Ganó Local?,¿Ganó Visita?
 1,herchu,2,1,Lord Trooper,12/08 - 09:30,https://boardgamearena.com/tournament?id=423761,,1,1,0
@@ -145,6 +168,41 @@ Duelo,Fecha 2,,,,18/08 - 24/08,Link,,¿Se jugó?,¿Ganó Local?,¿Ganó Visita?
 	}
 }
 
+func TestParseDivisionReader(t *testing.T) {
+	reader := strings.NewReader("Duelo,Fecha 1,,,,11/08 - 17/08,Link,,¿Se jugó?,¿Ganó Local?,¿Ganó Visita?\r\n" +
+		"1,herchu,2,1,Lord Trooper,12/08 - 09:30,https://boardgamearena.com/tournament?id=423761,,1,1,0\r\n" +
+		",,,,,,,,,,\r\n" +
+		"Duelo,Fecha 2,,,,18/08 - 24/08,Link,,¿Se jugó?,¿Ganó Local?,¿Ganó Visita?\r\n" +
+		"2,webbi,0,0,herchu,-,,,0,0,0\r\n" +
+		"3,Lord Trooper,0,2,alehrosario,21/08 - 16:00,,,1,0,1\r\n")
+
+	division, err := ParseDivisionReader(reader)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(division.Rounds) != 2 {
+		t.Fatalf("Expected 2 rounds, got %d", len(division.Rounds))
+	}
+
+	if division.Rounds[0].DateRange != "11/08 - 17/08" || len(division.Rounds[0].Matches) != 1 {
+		t.Errorf("Unexpected first round: %+v", division.Rounds[0])
+	}
+
+	second := division.Rounds[1]
+	if second.Number != 2 || len(second.Matches) != 2 {
+		t.Fatalf("Unexpected second round: %+v", second)
+	}
+
+	if match := second.Matches[1]; match.AwayPlayer != "alehrosario" || !match.Played || match.AwayScore != 2 {
+		t.Errorf("Unexpected last match: %+v", match)
+	}
+
+	if division.Season != DefaultSeason {
+		t.Errorf("Expected the default season, got %d", division.Season)
+	}
+}
+
 func TestParseDivision_RealFixtureFile(t *testing.T) {
 	// Test with a simplified version of the real fixture data
 	csvData := `Duelo,Fecha 1,,,,11/08 - 17/08,Link,,¿Se jugó?,¿Ganó Local?,¿Ganó Visita?
@@ -256,6 +314,22 @@ func TestParseFixtureFile_ActualCSV(t *testing.T) {
 		len(division.Rounds), playedCount, unplayedCount)
 }
 
+func TestDivisionNameFromFilename(t *testing.T) {
+	testCases := map[string]string{
+		"Liga Argentina - 1° Temporada - E-Fixture.csv":        "E",
+		"data/Liga Argentina - 1° Temporada - P.A-Fixture.csv": "P.A",
+		"Liga Argentina - E-Fixture.csv":                       "",
+		"Liga Argentina - 1° Temporada - E.csv":                "",
+		"fixture.csv":                                          "",
+	}
+
+	for filename, expected := range testCases {
+		if got := DivisionNameFromFilename(filename); got != expected {
+			t.Errorf("Expected %q for %q, got %q", expected, filename, got)
+		}
+	}
+}
+
 func TestGetUnplayedMatches(t *testing.T) {
 	csvData := `Duelo,Fecha 1,,,,11/08 - 17/08,Link,,¿Se jugó?,¿Ganó Local?,¿Ganó Visita?
 1,herchu,2,1,Lord Trooper,12/08 - 09:30,https://boardgamearena.com/tournament?id=423761,,1,1,0
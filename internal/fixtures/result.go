@@ -0,0 +1,44 @@
+package fixtures
+
+import "fmt"
+
+// RecordResult marks the match played with the games won by each player, replacing any walkover
+func (m *Match) RecordResult(homeScore, awayScore int) error {
+	if homeScore < 0 || awayScore < 0 {
+		return fmt.Errorf("invalid score %d-%d for match %d", homeScore, awayScore, m.ID)
+	}
+
+	m.HomeScore, m.AwayScore = homeScore, awayScore
+	m.Played = true
+	m.Walkover = false
+
+	return nil
+}
+
+// Winner returns the player who won the match, false when it is unplayed, drawn or forfeited by both players
+// A forfeiting player always loses, whatever the scores
+func (m *Match) Winner() (string, bool) {
+	if !m.Played || (m.HomeForfeited() && m.AwayForfeited()) {
+		return "", false
+	}
+
+	switch outcome(m.HomeScore, m.AwayScore) {
+	case "W":
+		return m.HomePlayer, true
+	case "L":
+		return m.AwayPlayer, true
+	default:
+		return "", false
+	}
+}
+
+// IsComplete reports whether every match of the round has been played
+func (r *Round) IsComplete() bool {
+	for _, match := range r.Matches {
+		if !match.Played {
+			return false
+		}
+	}
+
+	return true
+}
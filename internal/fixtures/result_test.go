@@ -0,0 +1,80 @@
+package fixtures
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMatch_RecordResult(t *testing.T) {
+	match := &Match{ID: 3, HomePlayer: "herchu", AwayPlayer: "webbi", Walkover: true}
+
+	if err := match.RecordResult(1, 2); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !match.Played || match.Walkover || match.HomeScore != 1 || match.AwayScore != 2 {
+		t.Errorf("Expected a played 1-2 result, got %+v", match)
+	}
+
+	division := &Division{Rounds: []*Round{{Number: 1, Matches: []*Match{match}}}}
+	if form := strings.Join(RecentForm(division, "webbi", 5), ""); form != "W" {
+		t.Errorf("Expected the result to count as a win for the away player, got %q", form)
+	}
+
+	if err := match.RecordResult(-1, 2); err == nil {
+		t.Error("Expected an error for a negative score")
+	}
+}
+
+func TestMatch_Winner(t *testing.T) {
+	testCases := []struct {
+		match      *Match
+		name       string
+		wantWinner string
+		wantOK     bool
+	}{
+		{
+			&Match{HomePlayer: "herchu", AwayPlayer: "webbi", HomeScore: 2, AwayScore: 1, Played: true},
+			"home win", "herchu", true,
+		},
+		{
+			&Match{HomePlayer: "herchu", AwayPlayer: "webbi", HomeScore: 0, AwayScore: 2, Played: true},
+			"away win", "webbi", true,
+		},
+		{&Match{HomePlayer: "herchu", AwayPlayer: "webbi", HomeScore: 1, AwayScore: 1, Played: true}, "draw", "", false},
+		{&Match{HomePlayer: "herchu", AwayPlayer: "webbi"}, "unplayed", "", false},
+		{
+			&Match{HomePlayer: "herchu", AwayPlayer: "webbi", HomeScore: ForfeitScore, AwayScore: 0, Played: true},
+			"home forfeit", "webbi", true,
+		},
+		{
+			&Match{HomePlayer: "herchu", AwayPlayer: "webbi", HomeScore: ForfeitScore, AwayScore: ForfeitScore, Played: true},
+			"both forfeited", "", false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			winner, ok := tc.match.Winner()
+			if winner != tc.wantWinner || ok != tc.wantOK {
+				t.Errorf("Expected (%q, %v), got (%q, %v)", tc.wantWinner, tc.wantOK, winner, ok)
+			}
+		})
+	}
+}
+
+func TestRound_IsComplete(t *testing.T) {
+	round := &Round{Number: 1, Matches: []*Match{
+		{ID: 1, HomePlayer: "herchu", AwayPlayer: "webbi", HomeScore: 2, AwayScore: 0, Played: true},
+		{ID: 2, HomePlayer: "Lord Trooper", AwayPlayer: "alehrosario"},
+	}}
+
+	if round.IsComplete() {
+		t.Error("Expected a round with an unplayed match to be incomplete")
+	}
+
+	round.Matches[1].Played = true
+	if !round.IsComplete() {
+		t.Error("Expected a round with every match played to be complete")
+	}
+}
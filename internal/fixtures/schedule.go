@@ -0,0 +1,242 @@
+package fixtures
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// MatchDateTimeLayout is the "DD/MM - HH:MM" format used in the fixture spreadsheets
+const MatchDateTimeLayout = "02/01 - 15:04"
+
+// MatchDateLayout is the date-only "DD/MM" form used before players agree a time
+const MatchDateLayout = "02/01"
+
+// ScheduleState describes how far an unplayed match is from having a BGA tournament
+type ScheduleState int
+
+const (
+	// Unscheduled matches have neither an agreed date nor a tournament
+	Unscheduled ScheduleState = iota
+	// ScheduledNeedsTournament matches have an agreed date but no tournament yet
+	ScheduledNeedsTournament
+	// TournamentCreated matches already have a BGA tournament link
+	TournamentCreated
+)
+
+// String returns a short label for the schedule state
+func (s ScheduleState) String() string {
+	switch s {
+	case ScheduledNeedsTournament:
+		return "needs tournament"
+	case TournamentCreated:
+		return "created"
+	default:
+		return "unscheduled"
+	}
+}
+
+// ScheduleState computes the schedule state of the match from its date and tournament link
+func (m *Match) ScheduleState() ScheduleState {
+	switch {
+	case m.BGALink != "":
+		return TournamentCreated
+	case m.HasAgreedDateTime():
+		return ScheduledNeedsTournament
+	default:
+		return Unscheduled
+	}
+}
+
+// HasAgreedDateTime reports whether players have agreed a date, ignoring the "-" placeholder
+func (m *Match) HasAgreedDateTime() bool {
+	dateTime := strings.TrimSpace(m.DateTime)
+	return dateTime != "" && dateTime != "-"
+}
+
+// ScheduledTime parses the agreed "DD/MM - HH:MM" datetime in the location of now
+// The CSV omits the year, so it is read in whichever of the previous, current and next year lands closest to now:
+// a January match agreed in December falls after New Year, and a December match seen in January stays before it
+func (m *Match) ScheduledTime(now time.Time) (time.Time, error) {
+	if _, err := time.Parse(MatchDateTimeLayout, strings.TrimSpace(m.DateTime)); err != nil {
+		return time.Time{}, fmt.Errorf("invalid match datetime %q: %w", m.DateTime, err)
+	}
+
+	var closest time.Time
+	for _, year := range []int{now.Year() - 1, now.Year(), now.Year() + 1} {
+		candidate, _ := m.parsedDateTimeIn(year, now.Location())
+		if closest.IsZero() || candidate.Sub(now).Abs() < closest.Sub(now).Abs() {
+			closest = candidate
+		}
+	}
+
+	return closest, nil
+}
+
+// ParsedDateTime interprets the match datetime in the given season year, since the CSV omits it
+// A date without time is read as midnight; false means the datetime is empty or malformed
+func (m *Match) ParsedDateTime(year int) (time.Time, bool) {
+	return m.parsedDateTimeIn(year, time.Local)
+}
+
+// parsedDateTimeIn reads the match datetime, or its date alone at midnight, in the given year and location
+func (m *Match) parsedDateTimeIn(year int, loc *time.Location) (time.Time, bool) {
+	value := strings.TrimSpace(m.DateTime)
+
+	for _, layout := range []string{MatchDateTimeLayout, MatchDateLayout} {
+		parsed, err := time.ParseInLocation(layout, value, loc)
+		if err != nil {
+			continue
+		}
+
+		return time.Date(year, parsed.Month(), parsed.Day(), parsed.Hour(), parsed.Minute(), 0, 0, loc), true
+	}
+
+	return time.Time{}, false
+}
+
+// ShiftDateTime moves the agreed datetime by offset, reading it in the given year and keeping its
+// "DD/MM - HH:MM" or date-only "DD/MM" form; false means the match has no readable datetime to shift
+func (m *Match) ShiftDateTime(offset time.Duration, year int) bool {
+	parsed, ok := m.ParsedDateTime(year)
+	if !ok {
+		return false
+	}
+
+	// Shift in UTC so a day offset crossing a daylight saving change keeps the agreed hour
+	shifted := time.Date(parsed.Year(), parsed.Month(), parsed.Day(), parsed.Hour(), parsed.Minute(), 0, 0, time.UTC).
+		Add(offset)
+
+	layout := MatchDateTimeLayout
+	if _, err := time.Parse(MatchDateTimeLayout, strings.TrimSpace(m.DateTime)); err != nil {
+		layout = MatchDateLayout
+	}
+
+	m.DateTime = shifted.Format(layout)
+	return true
+}
+
+// StartDate reads the first day of the round's "DD/MM - DD/MM" date range in the given year
+// False means the round has no readable date range
+func (r *Round) StartDate(year int) (time.Time, bool) {
+	start, _, ok := r.ParseDateRange(year)
+	return start, ok
+}
+
+// NearestStartDate reads the first day of the round's date range in whichever of the previous, current and next
+// year lands closest to now, as ScheduledTime does; false means the round has no readable date range
+func (r *Round) NearestStartDate(now time.Time) (time.Time, bool) {
+	var closest time.Time
+	for _, year := range []int{now.Year() - 1, now.Year(), now.Year() + 1} {
+		candidate, ok := r.StartDate(year)
+		if !ok {
+			return time.Time{}, false
+		}
+		if closest.IsZero() || candidate.Sub(now).Abs() < closest.Sub(now).Abs() {
+			closest = candidate
+		}
+	}
+
+	return closest, true
+}
+
+// ParseDateRange reads the first and last day of the round's "DD/MM - DD/MM" date range starting in the given year
+// An end month before the start month means the round crosses New Year, so the end falls in the next year
+// A single date is both the start and the end; false means the round has no readable date range
+func (r *Round) ParseDateRange(year int) (start, end time.Time, ok bool) {
+	first, last, hasEnd := strings.Cut(r.DateRange, "-")
+
+	start, ok = parseRoundDay(first, year)
+	if !ok {
+		return time.Time{}, time.Time{}, false
+	}
+	if !hasEnd {
+		return start, start, true
+	}
+
+	end, ok = parseRoundDay(last, year)
+	if !ok {
+		return time.Time{}, time.Time{}, false
+	}
+	if end.Month() < start.Month() {
+		end = end.AddDate(1, 0, 0)
+	}
+
+	return start, end, true
+}
+
+// ContainsDate reports whether the day of t falls within the round's date range, read in t's year
+// A range crossing New Year started the year before, so it is also tried a year earlier
+func (r *Round) ContainsDate(t time.Time) bool {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+
+	for _, year := range []int{t.Year(), t.Year() - 1} {
+		start, end, ok := r.ParseDateRange(year)
+		if ok && !day.Before(start) && !day.After(end) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NeedsTournaments reports whether the round has an unplayed match without a BGA tournament, byes aside
+func (r *Round) NeedsTournaments() bool {
+	for _, match := range r.Matches {
+		if !match.Played && !match.IsBye() && match.BGALink == "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AllTournamentsCreated reports whether every match of the round but its byes has a BGA tournament
+// A round without any such match is never fully created
+func (r *Round) AllTournamentsCreated() bool {
+	created := 0
+	for _, match := range r.Matches {
+		switch {
+		case match.IsBye():
+			continue
+		case match.BGALink == "":
+			return false
+		}
+		created++
+	}
+
+	return created > 0
+}
+
+// CurrentRound returns the index of the round whose date range contains now, falling back to the first round
+func (d *Division) CurrentRound(now time.Time) int {
+	for i, round := range d.Rounds {
+		if round.ContainsDate(now) {
+			return i
+		}
+	}
+
+	return 0
+}
+
+// NextRoundToSchedule returns the index of the earliest round with matches still needing tournaments
+// When no round needs any, it is the current round instead
+func (d *Division) NextRoundToSchedule(now time.Time) int {
+	for i, round := range d.Rounds {
+		if round.NeedsTournaments() {
+			return i
+		}
+	}
+
+	return d.CurrentRound(now)
+}
+
+// parseRoundDay reads a "DD/MM" day of a round's date range at midnight in the given year
+func parseRoundDay(value string, year int) (time.Time, bool) {
+	parsed, err := time.ParseInLocation(MatchDateLayout, strings.TrimSpace(value), time.Local)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return time.Date(year, parsed.Month(), parsed.Day(), 0, 0, 0, 0, time.Local), true
+}
@@ -0,0 +1,345 @@
+package fixtures
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMatch_ScheduleState(t *testing.T) {
+	testCases := []struct {
+		name     string
+		match    Match
+		expected ScheduleState
+	}{
+		{"no date and no link", Match{}, Unscheduled},
+		{"placeholder date", Match{DateTime: "-"}, Unscheduled},
+		{"agreed date without link", Match{DateTime: "15/03 21:00"}, ScheduledNeedsTournament},
+		{"link without date", Match{BGALink: "https://boardgamearena.com/tournament?id=423762"}, TournamentCreated},
+		{
+			"link and date",
+			Match{DateTime: "15/03 21:00", BGALink: "https://boardgamearena.com/tournament?id=423762"},
+			TournamentCreated,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.match.ScheduleState(); got != tc.expected {
+				t.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestMatch_ScheduledTime(t *testing.T) {
+	now := time.Date(2025, 8, 20, 12, 0, 0, 0, time.UTC)
+
+	parsed, err := (&Match{DateTime: "05/09 - 21:30"}).ScheduledTime(now)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := time.Date(2025, 9, 5, 21, 30, 0, 0, time.UTC)
+	if !parsed.Equal(expected) {
+		t.Errorf("Expected %v, got %v", expected, parsed)
+	}
+
+	for _, invalid := range []string{"", "-", "5 de septiembre", "32/09 - 21:30"} {
+		if _, err := (&Match{DateTime: invalid}).ScheduledTime(now); err == nil {
+			t.Errorf("Expected error for %q", invalid)
+		}
+	}
+}
+
+func TestMatch_ScheduledTime_AcrossNewYear(t *testing.T) {
+	now := time.Date(2025, 12, 28, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		expected time.Time
+		dateTime string
+	}{
+		{time.Date(2025, 12, 30, 21, 0, 0, 0, time.UTC), "30/12 - 21:00"},
+		{time.Date(2026, 1, 3, 21, 0, 0, 0, time.UTC), "03/01 - 21:00"},
+		{time.Date(2025, 12, 20, 21, 0, 0, 0, time.UTC), "20/12 - 21:00"},
+	}
+
+	for _, tc := range testCases {
+		parsed, err := (&Match{DateTime: tc.dateTime}).ScheduledTime(now)
+		if err != nil {
+			t.Fatalf("Unexpected error for %q: %v", tc.dateTime, err)
+		}
+
+		if !parsed.Equal(tc.expected) {
+			t.Errorf("Expected %q to be %v, got %v", tc.dateTime, tc.expected, parsed)
+		}
+	}
+}
+
+func TestMatch_ScheduledTime_DecemberSeenInJanuary(t *testing.T) {
+	now := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		expected time.Time
+		dateTime string
+	}{
+		{time.Date(2025, 12, 20, 21, 0, 0, 0, time.UTC), "20/12 - 21:00"},
+		{time.Date(2026, 1, 15, 21, 0, 0, 0, time.UTC), "15/01 - 21:00"},
+		{time.Date(2026, 3, 2, 21, 0, 0, 0, time.UTC), "02/03 - 21:00"},
+	}
+
+	for _, tc := range testCases {
+		parsed, err := (&Match{DateTime: tc.dateTime}).ScheduledTime(now)
+		if err != nil {
+			t.Fatalf("Unexpected error for %q: %v", tc.dateTime, err)
+		}
+
+		if !parsed.Equal(tc.expected) {
+			t.Errorf("Expected %q to be %v, got %v", tc.dateTime, tc.expected, parsed)
+		}
+	}
+}
+
+func TestMatch_ParsedDateTime(t *testing.T) {
+	testCases := []struct {
+		expected time.Time
+		name     string
+		dateTime string
+		ok       bool
+	}{
+		{time.Date(2025, 8, 12, 9, 30, 0, 0, time.Local), "full datetime", "12/08 - 09:30", true},
+		{time.Date(2025, 8, 13, 0, 0, 0, 0, time.Local), "date only", "13/08", true},
+		{time.Time{}, "empty", "", false},
+		{time.Time{}, "placeholder", "-", false},
+		{time.Time{}, "malformed", "next week", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			match := &Match{DateTime: tc.dateTime}
+
+			parsed, ok := match.ParsedDateTime(2025)
+			if ok != tc.ok {
+				t.Fatalf("Expected ok=%v for %q, got %v", tc.ok, tc.dateTime, ok)
+			}
+
+			if !parsed.Equal(tc.expected) {
+				t.Errorf("Expected %v, got %v", tc.expected, parsed)
+			}
+		})
+	}
+}
+
+func TestRound_StartDate(t *testing.T) {
+	testCases := []struct {
+		expected  time.Time
+		name      string
+		dateRange string
+		ok        bool
+	}{
+		{time.Date(2025, 8, 11, 0, 0, 0, 0, time.Local), "range", "11/08 - 17/08", true},
+		{time.Date(2025, 9, 1, 0, 0, 0, 0, time.Local), "single day", "01/09", true},
+		{time.Time{}, "empty", "", false},
+		{time.Time{}, "malformed", "TBD", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			round := &Round{DateRange: tc.dateRange}
+
+			start, ok := round.StartDate(2025)
+			if ok != tc.ok {
+				t.Fatalf("Expected ok=%v for %q, got %v", tc.ok, tc.dateRange, ok)
+			}
+
+			if !start.Equal(tc.expected) {
+				t.Errorf("Expected %v, got %v", tc.expected, start)
+			}
+		})
+	}
+}
+
+func TestRound_NearestStartDate(t *testing.T) {
+	testCases := []struct {
+		now       time.Time
+		expected  time.Time
+		name      string
+		dateRange string
+		ok        bool
+	}{
+		{
+			time.Date(2025, 8, 1, 12, 0, 0, 0, time.Local), time.Date(2025, 8, 11, 0, 0, 0, 0, time.Local),
+			"same year", "11/08 - 17/08", true,
+		},
+		{
+			time.Date(2025, 12, 20, 12, 0, 0, 0, time.Local), time.Date(2026, 1, 5, 0, 0, 0, 0, time.Local),
+			"january round seen in december", "05/01 - 11/01", true,
+		},
+		{
+			time.Date(2026, 1, 2, 12, 0, 0, 0, time.Local), time.Date(2025, 12, 29, 0, 0, 0, 0, time.Local),
+			"round across new year seen in january", "29/12 - 04/01", true,
+		},
+		{time.Date(2025, 8, 1, 12, 0, 0, 0, time.Local), time.Time{}, "malformed", "TBD", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			start, ok := (&Round{DateRange: tc.dateRange}).NearestStartDate(tc.now)
+			if ok != tc.ok {
+				t.Fatalf("Expected ok=%v for %q, got %v", tc.ok, tc.dateRange, ok)
+			}
+
+			if !start.Equal(tc.expected) {
+				t.Errorf("Expected %v, got %v", tc.expected, start)
+			}
+		})
+	}
+}
+
+func TestRound_ParseDateRange(t *testing.T) {
+	testCases := []struct {
+		start     time.Time
+		end       time.Time
+		name      string
+		dateRange string
+		ok        bool
+	}{
+		{
+			time.Date(2025, 8, 11, 0, 0, 0, 0, time.Local), time.Date(2025, 8, 17, 0, 0, 0, 0, time.Local),
+			"same month", "11/08 - 17/08", true,
+		},
+		{
+			time.Date(2025, 9, 29, 0, 0, 0, 0, time.Local), time.Date(2025, 10, 5, 0, 0, 0, 0, time.Local),
+			"next month", "29/09 - 05/10", true,
+		},
+		{
+			time.Date(2025, 12, 28, 0, 0, 0, 0, time.Local), time.Date(2026, 1, 3, 0, 0, 0, 0, time.Local),
+			"across new year", "28/12 - 03/01", true,
+		},
+		{
+			time.Date(2025, 9, 1, 0, 0, 0, 0, time.Local), time.Date(2025, 9, 1, 0, 0, 0, 0, time.Local),
+			"single day", "01/09", true,
+		},
+		{time.Time{}, time.Time{}, "malformed end", "28/12 - soon", false},
+		{time.Time{}, time.Time{}, "empty", "", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			start, end, ok := (&Round{DateRange: tc.dateRange}).ParseDateRange(2025)
+			if ok != tc.ok {
+				t.Fatalf("Expected ok=%v for %q, got %v", tc.ok, tc.dateRange, ok)
+			}
+
+			if !start.Equal(tc.start) || !end.Equal(tc.end) {
+				t.Errorf("Expected %v to %v, got %v to %v", tc.start, tc.end, start, end)
+			}
+		})
+	}
+}
+
+func TestRound_ContainsDate(t *testing.T) {
+	testCases := []struct {
+		date      time.Time
+		name      string
+		dateRange string
+		expected  bool
+	}{
+		{time.Date(2025, 8, 14, 20, 0, 0, 0, time.Local), "inside", "11/08 - 17/08", true},
+		{time.Date(2025, 8, 11, 0, 0, 0, 0, time.Local), "first day", "11/08 - 17/08", true},
+		{time.Date(2025, 8, 17, 23, 59, 0, 0, time.Local), "last day", "11/08 - 17/08", true},
+		{time.Date(2025, 8, 10, 12, 0, 0, 0, time.Local), "before", "11/08 - 17/08", false},
+		{time.Date(2025, 8, 18, 0, 0, 0, 0, time.Local), "after", "11/08 - 17/08", false},
+		{time.Date(2025, 12, 30, 0, 0, 0, 0, time.Local), "december of new year round", "28/12 - 03/01", true},
+		{time.Date(2026, 1, 2, 0, 0, 0, 0, time.Local), "january of new year round", "28/12 - 03/01", true},
+		{time.Date(2025, 9, 1, 0, 0, 0, 0, time.Local), "single day", "01/09", true},
+		{time.Date(2025, 8, 14, 0, 0, 0, 0, time.Local), "no range", "", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := (&Round{DateRange: tc.dateRange}).ContainsDate(tc.date); got != tc.expected {
+				t.Errorf("Expected %v for %v in %q, got %v", tc.expected, tc.date, tc.dateRange, got)
+			}
+		})
+	}
+}
+
+func TestDivision_NextRoundToSchedule(t *testing.T) {
+	division := &Division{
+		Rounds: []*Round{
+			{Number: 1, DateRange: "11/08 - 17/08", Matches: []*Match{
+				{ID: 1, Played: true},
+				{ID: 2, BGALink: "https://boardgamearena.com/tournament?id=1"},
+			}},
+			{Number: 2, DateRange: "18/08 - 24/08", Matches: []*Match{
+				{ID: 3, BGALink: "https://boardgamearena.com/tournament?id=2"},
+			}},
+			{Number: 3, DateRange: "25/08 - 31/08", Matches: []*Match{
+				{ID: 4, BGALink: "https://boardgamearena.com/tournament?id=3"},
+				{ID: 5, HomePlayer: "herchu", AwayPlayer: "webbi"},
+			}},
+			{Number: 4, DateRange: "01/09 - 07/09", Matches: []*Match{{ID: 6, HomePlayer: "webbi", AwayPlayer: "herchu"}}},
+		},
+	}
+	today := time.Date(2025, 8, 19, 12, 0, 0, 0, time.Local)
+
+	if got := division.NextRoundToSchedule(today); got != 2 {
+		t.Errorf("Expected the third round to need work, got index %d", got)
+	}
+
+	division.Rounds[2].Matches[1].BGALink = "https://boardgamearena.com/tournament?id=4"
+	division.Rounds[3].Matches[0].Played = true
+
+	if got := division.NextRoundToSchedule(today); got != 1 {
+		t.Errorf("Expected the current round once nothing needs work, got index %d", got)
+	}
+}
+
+func TestMatch_ShiftDateTime(t *testing.T) {
+	testCases := []struct {
+		name     string
+		dateTime string
+		expected string
+		offset   time.Duration
+		ok       bool
+	}{
+		{"a week later", "12/08 - 21:30", "19/08 - 21:30", 7 * 24 * time.Hour, true},
+		{"into the next month", "28/08 - 21:30", "04/09 - 21:30", 7 * 24 * time.Hour, true},
+		{"back a few hours", "12/08 - 01:00", "11/08 - 22:00", -3 * time.Hour, true},
+		{"date only", "30/12", "06/01", 7 * 24 * time.Hour, true},
+		{"placeholder", "-", "-", 7 * 24 * time.Hour, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			match := &Match{DateTime: tc.dateTime}
+
+			if ok := match.ShiftDateTime(tc.offset, 2025); ok != tc.ok {
+				t.Fatalf("Expected ok=%v, got %v", tc.ok, ok)
+			}
+			if match.DateTime != tc.expected {
+				t.Errorf("Expected %q, got %q", tc.expected, match.DateTime)
+			}
+		})
+	}
+}
+
+func TestRound_AllTournamentsCreated(t *testing.T) {
+	round := &Round{Number: 1, Matches: []*Match{
+		{ID: 1, HomePlayer: "herchu", AwayPlayer: "webbi", BGALink: "https://boardgamearena.com/tournament?id=423761"},
+		{ID: 2, HomePlayer: "Lord Trooper", AwayPlayer: "alehrosario"},
+	}}
+
+	if round.AllTournamentsCreated() {
+		t.Error("Expected a round with a match missing its tournament not to be fully created")
+	}
+
+	round.Matches[1].BGALink = "https://boardgamearena.com/tournament?id=423762"
+	if !round.AllTournamentsCreated() {
+		t.Error("Expected a round with every tournament linked to be fully created")
+	}
+
+	if (&Round{Number: 2}).AllTournamentsCreated() {
+		t.Error("Expected an empty round not to count as fully created")
+	}
+}
@@ -0,0 +1,77 @@
+package fixtures
+
+import "fmt"
+
+// ScoreMode tells how the two numbers of a Duelo result are read
+type ScoreMode int
+
+const (
+	// ScoreSets reads the result as games won in the best-of-N, e.g. 2-1
+	ScoreSets ScoreMode = iota
+	// ScorePoints reads the result as aggregate points over the whole Duelo, e.g. 187-160
+	ScorePoints
+)
+
+// FixtureScoreMode is how fixture results are interpreted for standings and display
+var FixtureScoreMode = ScoreSets
+
+// scoreModeNames maps each score mode to the name used on the command line
+var scoreModeNames = map[ScoreMode]string{
+	ScoreSets:   "sets",
+	ScorePoints: "points",
+}
+
+// String returns the command line name of the score mode
+func (s *ScoreMode) String() string {
+	return scoreModeNames[*s]
+}
+
+// Set parses a score mode name, so a ScoreMode can be used as a flag
+func (s *ScoreMode) Set(value string) error {
+	for mode, name := range scoreModeNames {
+		if name == value {
+			*s = mode
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unknown score mode %q, expected \"sets\" or \"points\"", value)
+}
+
+// TiebreakName returns what the standings tiebreak counts under the score mode
+func (s ScoreMode) TiebreakName() string {
+	if s == ScorePoints {
+		return "points scored"
+	}
+
+	return "game difference"
+}
+
+// Tiebreak returns what the match adds to the player's standings tiebreak under the score mode
+// Sets count as a game difference, so a 2-1 is +1 for the winner and -1 for the loser
+// Aggregate points count as the points the player scored, so the same 2-1 adds 2 and 1
+// Either way a 2-0 Duelo weighs more than a 1-0 one; forfeits and matches the player is not in add nothing
+func (m *Match) Tiebreak(player string, mode ScoreMode) int {
+	own, opponent, ok := m.scoresFor(player)
+	if !ok || !m.Played || m.HomeForfeited() || m.AwayForfeited() {
+		return 0
+	}
+
+	if mode == ScorePoints {
+		return own
+	}
+
+	return own - opponent
+}
+
+// scoresFor returns the player's score and the opponent's, false when the player is not in the match
+func (m *Match) scoresFor(player string) (own, opponent int, ok bool) {
+	switch player {
+	case m.HomePlayer:
+		return m.HomeScore, m.AwayScore, true
+	case m.AwayPlayer:
+		return m.AwayScore, m.HomeScore, true
+	default:
+		return 0, 0, false
+	}
+}
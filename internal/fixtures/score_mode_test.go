@@ -0,0 +1,52 @@
+package fixtures
+
+import "testing"
+
+func TestMatch_Tiebreak(t *testing.T) {
+	testCases := []struct {
+		name     string
+		match    Match
+		mode     ScoreMode
+		homeWant int
+		awayWant int
+	}{
+		{"sets 2-1", Match{HomeScore: 2, AwayScore: 1}, ScoreSets, 1, -1},
+		{"points 2-1", Match{HomeScore: 2, AwayScore: 1}, ScorePoints, 2, 1},
+		{"sets 2-0", Match{HomeScore: 2, AwayScore: 0}, ScoreSets, 2, -2},
+		{"points 160-187", Match{HomeScore: 160, AwayScore: 187}, ScorePoints, 160, 187},
+		{"forfeit", Match{HomeScore: ForfeitScore, AwayScore: 0}, ScorePoints, 0, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			match := tc.match
+			match.HomePlayer, match.AwayPlayer, match.Played = "herchu", "Lord Trooper", true
+
+			if got := match.Tiebreak("herchu", tc.mode); got != tc.homeWant {
+				t.Errorf("Expected home tiebreak %d, got %d", tc.homeWant, got)
+			}
+			if got := match.Tiebreak("Lord Trooper", tc.mode); got != tc.awayWant {
+				t.Errorf("Expected away tiebreak %d, got %d", tc.awayWant, got)
+			}
+			if got := match.Tiebreak("webbi", tc.mode); got != 0 {
+				t.Errorf("Expected no tiebreak for a player outside the match, got %d", got)
+			}
+		})
+	}
+}
+
+func TestScoreMode_Set(t *testing.T) {
+	var mode ScoreMode
+
+	if err := mode.Set("points"); err != nil || mode != ScorePoints {
+		t.Errorf("Expected points mode, got %v (err %v)", mode, err)
+	}
+
+	if mode.String() != "points" {
+		t.Errorf("Expected String to round-trip, got %q", mode.String())
+	}
+
+	if err := mode.Set("goals"); err == nil {
+		t.Error("Expected an error for an unknown score mode")
+	}
+}
@@ -0,0 +1,152 @@
+package fixtures
+
+import (
+	"sort"
+	"strings"
+)
+
+// WinPoints is how many table points a Duelo win is worth
+const WinPoints = 3
+
+// PlayerStanding is a player's line in the division table
+type PlayerStanding struct {
+	Player       string
+	Played       int
+	Won          int
+	Lost         int
+	GamesFor     int // Raw scores won, sets or points depending on FixtureScoreMode
+	GamesAgainst int
+	Points       int
+	Tiebreak     int // Orders players level on points, as Match.Tiebreak adds it up under FixtureScoreMode
+}
+
+// GameDifference returns the games won minus the games lost
+func (s PlayerStanding) GameDifference() int {
+	return s.GamesFor - s.GamesAgainst
+}
+
+// ComputeStandings tallies the played matches into the division table
+// Players are ordered by points, then the score mode's tiebreak, then name so complete ties always sort the same way
+// A walkover counts as a win for the player who showed up, without games for either side
+func ComputeStandings(d *Division) []PlayerStanding {
+	players := divisionPlayers(d)
+	standings := make([]PlayerStanding, len(players))
+	index := make(map[string]int, len(players))
+
+	for i, player := range players {
+		standings[i].Player = player
+		index[player] = i
+	}
+
+	for _, round := range d.Rounds {
+		for _, match := range round.Matches {
+			if !match.Played || match.IsBye() {
+				continue
+			}
+
+			for _, player := range []string{match.HomePlayer, match.AwayPlayer} {
+				tallyMatch(&standings[index[player]], match)
+			}
+		}
+	}
+
+	sort.Slice(standings, func(i, j int) bool {
+		a, b := standings[i], standings[j]
+		switch {
+		case a.Points != b.Points:
+			return a.Points > b.Points
+		case a.Tiebreak != b.Tiebreak:
+			return a.Tiebreak > b.Tiebreak
+		case !strings.EqualFold(a.Player, b.Player):
+			return strings.ToLower(a.Player) < strings.ToLower(b.Player)
+		default:
+			return a.Player < b.Player
+		}
+	})
+
+	return standings
+}
+
+// tallyMatch adds a played match to the standing of one of its players
+func tallyMatch(standing *PlayerStanding, match *Match) {
+	own, opponent, ok := match.scoresFor(standing.Player)
+	if !ok {
+		return
+	}
+
+	standing.Played++
+
+	switch outcome(own, opponent) {
+	case "W":
+		standing.Won++
+		standing.Points += WinPoints
+	case "L":
+		standing.Lost++
+	}
+
+	if match.HomeForfeited() || match.AwayForfeited() {
+		return
+	}
+
+	standing.GamesFor += own
+	standing.GamesAgainst += opponent
+	standing.Tiebreak += match.Tiebreak(standing.Player, FixtureScoreMode)
+}
+
+// RecentForm returns the player's last n results ("W", "L" or "D") in chronological order
+// A forfeit counts as a loss for the forfeiting player and a win for the opponent
+// Fewer than n entries are returned when the player has not played that many matches
+func RecentForm(d *Division, player string, n int) []string {
+	var results []string
+
+	for _, round := range d.Rounds {
+		for _, match := range round.Matches {
+			if !match.Played || match.IsBye() {
+				continue
+			}
+
+			own, opponent, ok := match.scoresFor(player)
+			if !ok {
+				continue
+			}
+
+			results = append(results, outcome(own, opponent))
+		}
+	}
+
+	if n > 0 && len(results) > n {
+		results = results[len(results)-n:]
+	}
+
+	return results
+}
+
+// HeadToHead tallies the played Duelos between two players, whichever of them was at home
+// Draws count as played without a win for either side
+func HeadToHead(d *Division, playerA, playerB string) (aWins, bWins, played int) {
+	for _, round := range d.Rounds {
+		for _, match := range round.Matches {
+			if !match.Played || !match.involves(playerA, playerB) {
+				continue
+			}
+
+			own, opponent, _ := match.scoresFor(playerA)
+			played++
+
+			switch outcome(own, opponent) {
+			case "W":
+				aWins++
+			case "L":
+				bWins++
+			}
+		}
+	}
+
+	return aWins, bWins, played
+}
+
+// involves reports whether the match is between the two players, in either order
+func (m *Match) involves(playerA, playerB string) bool {
+	return (m.HomePlayer == playerA && m.AwayPlayer == playerB) ||
+		(m.HomePlayer == playerB && m.AwayPlayer == playerA)
+}
@@ -0,0 +1,268 @@
+package fixtures
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRecentForm_KnownSequence(t *testing.T) {
+	division := &Division{
+		Name: "Elite",
+		Rounds: []*Round{
+			{Number: 1, Matches: []*Match{
+				{ID: 1, HomePlayer: "herchu", HomeScore: 2, AwayScore: 0, AwayPlayer: "webbi", Played: true},
+			}},
+			{Number: 2, Matches: []*Match{
+				{ID: 2, HomePlayer: "Lord Trooper", HomeScore: 1, AwayScore: 2, AwayPlayer: "herchu", Played: true},
+			}},
+			{Number: 3, Matches: []*Match{
+				{ID: 3, HomePlayer: "herchu", HomeScore: 1, AwayScore: 2, AwayPlayer: "alehrosario", Played: true},
+			}},
+			{Number: 4, Matches: []*Match{
+				{ID: 4, HomePlayer: "Academia47", HomeScore: 0, AwayScore: 2, AwayPlayer: "herchu", Played: true},
+			}},
+			{Number: 5, Matches: []*Match{
+				{ID: 5, HomePlayer: "herchu", HomeScore: 1, AwayScore: 2, AwayPlayer: "bignacho610", Played: true},
+			}},
+			{Number: 6, Matches: []*Match{
+				{ID: 6, HomePlayer: "herchu", HomeScore: 0, AwayScore: 0, AwayPlayer: "maticarrizoc", Played: false},
+			}},
+		},
+	}
+
+	form := strings.Join(RecentForm(division, "herchu", 5), "")
+	if form != "WWLWL" {
+		t.Errorf("Expected form 'WWLWL', got %q", form)
+	}
+
+	form = strings.Join(RecentForm(division, "herchu", 3), "")
+	if form != "LWL" {
+		t.Errorf("Expected last 3 results 'LWL', got %q", form)
+	}
+}
+
+func TestRecentForm_FewerThanN(t *testing.T) {
+	division := &Division{
+		Rounds: []*Round{
+			{Number: 1, Matches: []*Match{
+				{ID: 1, HomePlayer: "herchu", HomeScore: 1, AwayScore: 1, AwayPlayer: "webbi", Played: true},
+				{ID: 2, HomePlayer: "Lord Trooper", HomeScore: 2, AwayScore: 0, AwayPlayer: "alehrosario", Played: true},
+			}},
+		},
+	}
+
+	form := RecentForm(division, "webbi", 5)
+	if len(form) != 1 || form[0] != "D" {
+		t.Errorf("Expected a single draw, got %v", form)
+	}
+}
+
+func TestRecentForm_NoPlayedMatches(t *testing.T) {
+	division := &Division{
+		Rounds: []*Round{
+			{Number: 1, Matches: []*Match{
+				{ID: 17, HomePlayer: "webbi", AwayPlayer: "herchu", Played: false},
+			}},
+		},
+	}
+
+	form := RecentForm(division, "herchu", 5)
+	if len(form) != 0 {
+		t.Errorf("Expected empty form for a player without played matches, got %v", form)
+	}
+}
+
+func TestComputeStandings(t *testing.T) {
+	division := &Division{
+		Name: "Elite",
+		Rounds: []*Round{
+			{Number: 1, Matches: []*Match{
+				{ID: 1, HomePlayer: "herchu", HomeScore: 2, AwayScore: 1, AwayPlayer: "webbi", Played: true},
+				{ID: 2, HomePlayer: "Lord Trooper", HomeScore: 0, AwayScore: 2, AwayPlayer: "alehrosario", Played: true},
+			}},
+			{Number: 2, Matches: []*Match{
+				{ID: 3, HomePlayer: "webbi", HomeScore: 2, AwayScore: 0, AwayPlayer: "Lord Trooper", Played: true},
+				{ID: 4, HomePlayer: "alehrosario", HomeScore: 2, AwayScore: 1, AwayPlayer: "herchu", Played: true},
+			}},
+			{Number: 3, Matches: []*Match{
+				{ID: 5, HomePlayer: "herchu", HomeScore: 2, AwayScore: 0, AwayPlayer: "Lord Trooper", Played: true},
+				{ID: 6, HomePlayer: "alehrosario", HomeScore: 0, AwayScore: 0, AwayPlayer: "webbi", Played: false},
+			}},
+		},
+	}
+
+	standings := ComputeStandings(division)
+
+	expected := []PlayerStanding{
+		{Player: "alehrosario", Played: 2, Won: 2, GamesFor: 4, GamesAgainst: 1, Points: 6, Tiebreak: 3},
+		{Player: "herchu", Played: 3, Won: 2, Lost: 1, GamesFor: 5, GamesAgainst: 3, Points: 6, Tiebreak: 2},
+		{Player: "webbi", Played: 2, Won: 1, Lost: 1, GamesFor: 3, GamesAgainst: 2, Points: 3, Tiebreak: 1},
+		{Player: "Lord Trooper", Played: 3, Lost: 3, GamesFor: 0, GamesAgainst: 6, Points: 0, Tiebreak: -6},
+	}
+
+	if len(standings) != len(expected) {
+		t.Fatalf("Expected %d players, got %d", len(expected), len(standings))
+	}
+
+	for i, want := range expected {
+		if standings[i] != want {
+			t.Errorf("Position %d: expected %+v, got %+v", i+1, want, standings[i])
+		}
+	}
+}
+
+func TestComputeStandings_CompleteTiesSortByName(t *testing.T) {
+	division := &Division{
+		Rounds: []*Round{
+			{Number: 1, Matches: []*Match{
+				{ID: 1, HomePlayer: "webbi", AwayPlayer: "herchu"},
+				{ID: 2, HomePlayer: "Lord Trooper", AwayPlayer: "alehrosario"},
+			}},
+		},
+	}
+
+	for attempt := 0; attempt < 5; attempt++ {
+		var players []string
+		for _, standing := range ComputeStandings(division) {
+			players = append(players, standing.Player)
+		}
+
+		if got := strings.Join(players, ","); got != "alehrosario,herchu,Lord Trooper,webbi" {
+			t.Fatalf("Expected alphabetical order for fully tied players, got %s", got)
+		}
+	}
+}
+
+func TestComputeStandings_SkipsByes(t *testing.T) {
+	division := threePlayerRoundRobinWithByes()
+	division.Rounds[0].Matches[0].Played, division.Rounds[0].Matches[0].HomeScore = true, 2
+
+	standings := ComputeStandings(division)
+	if len(standings) != 3 {
+		t.Fatalf("Expected three players without the bye, got %+v", standings)
+	}
+
+	for _, standing := range standings {
+		if standing.Player == "BYE" {
+			t.Errorf("Expected the bye sentinel left out of the standings, got %+v", standings)
+		}
+		if standing.Player == "herchu" && standing.Played != 1 {
+			t.Errorf("Expected herchu's played bye not to count, got %+v", standing)
+		}
+	}
+}
+
+func TestComputeStandings_Walkover(t *testing.T) {
+	match := &Match{ID: 1, HomePlayer: "herchu", AwayPlayer: "webbi"}
+	if err := match.MarkWalkover("webbi"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	standings := ComputeStandings(&Division{Rounds: []*Round{{Number: 1, Matches: []*Match{match}}}})
+
+	winner, loser := standings[0], standings[1]
+	if winner.Player != "webbi" || winner.Won != 1 || winner.Points != WinPoints {
+		t.Errorf("Expected webbi to get the walkover win, got %+v", winner)
+	}
+
+	if loser.Lost != 1 || loser.GamesFor != 0 || loser.GamesAgainst != 0 || winner.GameDifference() != 0 {
+		t.Errorf("Expected the walkover to add no games, got %+v and %+v", winner, loser)
+	}
+}
+
+// useScoreMode sets FixtureScoreMode for the rest of the test
+func useScoreMode(t *testing.T, mode ScoreMode) {
+	t.Helper()
+
+	previous := FixtureScoreMode
+	FixtureScoreMode = mode
+	t.Cleanup(func() { FixtureScoreMode = previous })
+}
+
+func TestComputeStandings_TiebreakDependsOnScoreMode(t *testing.T) {
+	// The 2-1 is worth +1 in sets, level with the 1-0 so the name decides, but 2 points scored against 1
+	division := &Division{Rounds: []*Round{{Number: 1, Matches: []*Match{
+		{ID: 1, HomePlayer: "herchu", HomeScore: 2, AwayScore: 1, AwayPlayer: "webbi", Played: true},
+		{ID: 2, HomePlayer: "Academia47", HomeScore: 1, AwayScore: 0, AwayPlayer: "alehrosario", Played: true},
+	}}}}
+
+	testCases := []struct {
+		mode          ScoreMode
+		leader        string
+		herchu, webbi int
+	}{
+		{ScoreSets, "Academia47", 1, -1},
+		{ScorePoints, "herchu", 2, 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.mode.String(), func(t *testing.T) {
+			useScoreMode(t, tc.mode)
+
+			standings := ComputeStandings(division)
+			if standings[0].Player != tc.leader {
+				t.Errorf("Expected %s to lead, got %+v", tc.leader, standings)
+			}
+
+			tiebreaks := make(map[string]int)
+			for _, standing := range standings {
+				tiebreaks[standing.Player] = standing.Tiebreak
+			}
+
+			if tiebreaks["herchu"] != tc.herchu || tiebreaks["webbi"] != tc.webbi {
+				t.Errorf("Expected the 2-1 to give herchu %d and webbi %d, got %v", tc.herchu, tc.webbi, tiebreaks)
+			}
+		})
+	}
+}
+
+func TestComputeStandings_PointsModeRanksByPointsScored(t *testing.T) {
+	useScoreMode(t, ScorePoints)
+
+	division := &Division{Rounds: []*Round{{Number: 1, Matches: []*Match{
+		{ID: 1, HomePlayer: "herchu", HomeScore: 1, AwayScore: 0, AwayPlayer: "webbi", Played: true},
+		{ID: 2, HomePlayer: "Lord Trooper", HomeScore: 2, AwayScore: 0, AwayPlayer: "alehrosario", Played: true},
+	}}}}
+
+	standings := ComputeStandings(division)
+
+	if standings[0].Player != "Lord Trooper" || standings[0].Tiebreak != 2 {
+		t.Errorf("Expected Lord Trooper first with 2 points scored after the 2-0 win, got %+v", standings[0])
+	}
+
+	if standings[1].Player != "herchu" || standings[1].Tiebreak != 1 {
+		t.Errorf("Expected herchu second with 1 point scored after the 1-0 win, got %+v", standings[1])
+	}
+}
+
+func TestHeadToHead(t *testing.T) {
+	division := &Division{
+		Rounds: []*Round{
+			{Number: 1, Matches: []*Match{
+				{ID: 1, HomePlayer: "herchu", AwayPlayer: "Lord Trooper", HomeScore: 2, AwayScore: 1, Played: true},
+				{ID: 2, HomePlayer: "webbi", AwayPlayer: "herchu", HomeScore: 2, AwayScore: 0, Played: true},
+			}},
+			{Number: 8, Matches: []*Match{
+				// Reversed home and away: Lord Trooper wins at home
+				{ID: 15, HomePlayer: "Lord Trooper", AwayPlayer: "herchu", HomeScore: 2, AwayScore: 0, Played: true},
+			}},
+			{Number: 9, Matches: []*Match{
+				{ID: 17, HomePlayer: "herchu", AwayPlayer: "Lord Trooper"},
+			}},
+		},
+	}
+
+	aWins, bWins, played := HeadToHead(division, "herchu", "Lord Trooper")
+	if aWins != 1 || bWins != 1 || played != 2 {
+		t.Errorf("Expected herchu 1-1 Lord Trooper over 2 meetings, got %d-%d over %d", aWins, bWins, played)
+	}
+
+	aWins, bWins, played = HeadToHead(division, "Lord Trooper", "herchu")
+	if aWins != 1 || bWins != 1 || played != 2 {
+		t.Errorf("Expected the same tally with the players swapped, got %d-%d over %d", aWins, bWins, played)
+	}
+
+	if _, _, played := HeadToHead(division, "herchu", "alehrosario"); played != 0 {
+		t.Errorf("Expected no meetings between players who never met, got %d", played)
+	}
+}
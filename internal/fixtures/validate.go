@@ -0,0 +1,149 @@
+package fixtures
+
+import "fmt"
+
+// ValidateRoundRobin checks that the division forms a consistent round-robin, leaving byes out of the pairings
+// Each problem found is returned as a human-readable description; nil means the fixture is consistent
+func ValidateRoundRobin(d *Division) []string {
+	players := divisionPlayers(d)
+	if len(players) < 2 {
+		return []string{fmt.Sprintf("division has %d players, need at least 2", len(players))}
+	}
+
+	var problems []string
+
+	// An odd player count gives everyone a bye, so each leg needs one extra round
+	roundsPerLeg := len(players) - 1
+	if len(players)%2 != 0 {
+		roundsPerLeg = len(players)
+	}
+
+	legs := len(d.Rounds) / roundsPerLeg
+	if legs == 0 || len(d.Rounds)%roundsPerLeg != 0 {
+		problems = append(problems, fmt.Sprintf("%d rounds is not a whole round-robin for %d players (%d rounds per leg)",
+			len(d.Rounds), len(players), roundsPerLeg))
+		legs = max(legs, 1)
+	}
+
+	meetings := make(map[[2]string]int)
+	matchesPerRound := len(players) / 2
+
+	for i, round := range d.Rounds {
+		number := round.Number
+		if number == 0 {
+			number = i + 1
+		}
+
+		matches := 0
+		seen := make(map[string]bool)
+		for _, match := range round.Matches {
+			if match.IsBye() {
+				continue
+			}
+			matches++
+
+			for _, player := range []string{match.HomePlayer, match.AwayPlayer} {
+				if seen[player] {
+					problems = append(problems, fmt.Sprintf("round %d: %s plays more than once", number, player))
+				}
+				seen[player] = true
+			}
+
+			meetings[pairKey(match.HomePlayer, match.AwayPlayer)]++
+		}
+
+		if matches != matchesPerRound {
+			problems = append(problems, fmt.Sprintf("round %d has %d matches, expected %d",
+				number, matches, matchesPerRound))
+		}
+	}
+
+	for i, home := range players {
+		for _, away := range players[i+1:] {
+			if count := meetings[pairKey(home, away)]; count != legs {
+				problems = append(problems, fmt.Sprintf("%s and %s meet %d times, expected %d",
+					home, away, count, legs))
+			}
+		}
+	}
+
+	return problems
+}
+
+// ValidateDivision checks a parsed fixture for the mistakes hand edits leave behind:
+// duplicate or skipped match (Duelo) numbers, matches missing a player and played matches without a BGA link
+// Walkovers are allowed without a link since no tournament is played for them
+func ValidateDivision(d *Division) []error {
+	var problems []error
+	firstSeen := make(map[int]int)
+	maxID := 0
+
+	for i, round := range d.Rounds {
+		number := round.Number
+		if number == 0 {
+			number = i + 1
+		}
+
+		for _, match := range round.Matches {
+			switch previous, duplicate := firstSeen[match.ID]; {
+			case match.ID <= 0:
+				problems = append(problems, fmt.Errorf("round %d: %s vs %s has no match number",
+					number, match.HomePlayer, match.AwayPlayer))
+			case duplicate:
+				problems = append(problems, fmt.Errorf("match %d appears in round %d and round %d",
+					match.ID, previous, number))
+			default:
+				firstSeen[match.ID] = number
+				maxID = max(maxID, match.ID)
+			}
+
+			// A bye has no opponent to name and no tournament to link
+			if match.IsBye() {
+				continue
+			}
+
+			if match.HomePlayer == "" || match.AwayPlayer == "" {
+				problems = append(problems, fmt.Errorf("match %d has a missing player name", match.ID))
+			}
+
+			if match.Played && !match.Walkover && match.BGALink == "" {
+				problems = append(problems, fmt.Errorf("match %d is played but has no BGA link", match.ID))
+			}
+		}
+	}
+
+	for id := 1; id < maxID; id++ {
+		if _, ok := firstSeen[id]; !ok {
+			problems = append(problems, fmt.Errorf("match %d is missing", id))
+		}
+	}
+
+	return problems
+}
+
+// divisionPlayers lists every player in the division in order of first appearance, the bye sentinel aside
+func divisionPlayers(d *Division) []string {
+	var players []string
+	seen := make(map[string]bool)
+
+	for _, round := range d.Rounds {
+		for _, match := range round.Matches {
+			for _, player := range []string{match.HomePlayer, match.AwayPlayer} {
+				if !match.isByeName(player) && !seen[player] {
+					seen[player] = true
+					players = append(players, player)
+				}
+			}
+		}
+	}
+
+	return players
+}
+
+// pairKey identifies a pairing regardless of which player is at home
+func pairKey(a, b string) [2]string {
+	if a > b {
+		a, b = b, a
+	}
+	return [2]string{a, b}
+}
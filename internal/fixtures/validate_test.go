@@ -0,0 +1,191 @@
+package fixtures
+
+import (
+	"strings"
+	"testing"
+)
+
+// fourPlayerRoundRobin builds a single-leg round-robin for four players
+func fourPlayerRoundRobin() *Division {
+	return &Division{
+		Name: "Elite",
+		Rounds: []*Round{
+			{Number: 1, Matches: []*Match{
+				{ID: 1, HomePlayer: "herchu", AwayPlayer: "webbi"},
+				{ID: 2, HomePlayer: "Lord Trooper", AwayPlayer: "alehrosario"},
+			}},
+			{Number: 2, Matches: []*Match{
+				{ID: 3, HomePlayer: "herchu", AwayPlayer: "Lord Trooper"},
+				{ID: 4, HomePlayer: "alehrosario", AwayPlayer: "webbi"},
+			}},
+			{Number: 3, Matches: []*Match{
+				{ID: 5, HomePlayer: "alehrosario", AwayPlayer: "herchu"},
+				{ID: 6, HomePlayer: "webbi", AwayPlayer: "Lord Trooper"},
+			}},
+		},
+	}
+}
+
+// threePlayerRoundRobinWithByes builds a single-leg round-robin for three players, one sitting out each round
+func threePlayerRoundRobinWithByes() *Division {
+	return &Division{
+		Name: "Plata",
+		Rounds: []*Round{
+			{Number: 1, Matches: []*Match{
+				{ID: 1, HomePlayer: "herchu", AwayPlayer: "webbi"},
+				{ID: 2, HomePlayer: "Lord Trooper", AwayPlayer: "BYE"},
+			}},
+			{Number: 2, Matches: []*Match{
+				{ID: 3, HomePlayer: "webbi", AwayPlayer: "Lord Trooper"},
+				{ID: 4, HomePlayer: "BYE", AwayPlayer: "herchu", Played: true},
+			}},
+			{Number: 3, Matches: []*Match{
+				{ID: 5, HomePlayer: "Lord Trooper", AwayPlayer: "herchu"},
+				{ID: 6, HomePlayer: "webbi", AwayPlayer: "BYE"},
+			}},
+		},
+	}
+}
+
+func TestValidateRoundRobin_Valid(t *testing.T) {
+	if problems := ValidateRoundRobin(fourPlayerRoundRobin()); len(problems) != 0 {
+		t.Errorf("Expected no problems for a valid round-robin, got %v", problems)
+	}
+}
+
+func TestValidateRoundRobin_ByesAreNotPlayers(t *testing.T) {
+	if problems := ValidateRoundRobin(threePlayerRoundRobinWithByes()); len(problems) != 0 {
+		t.Errorf("Expected no problems for a round-robin with byes, got %v", problems)
+	}
+}
+
+func TestValidateRoundRobin_DoubleLeg(t *testing.T) {
+	division := fourPlayerRoundRobin()
+	for _, round := range fourPlayerRoundRobin().Rounds {
+		round.Number += 3
+		division.Rounds = append(division.Rounds, round)
+	}
+
+	if problems := ValidateRoundRobin(division); len(problems) != 0 {
+		t.Errorf("Expected no problems for a home-and-away round-robin, got %v", problems)
+	}
+}
+
+func TestValidateRoundRobin_DuplicatePairing(t *testing.T) {
+	division := fourPlayerRoundRobin()
+	// herchu meets webbi again in round 3 instead of alehrosario
+	division.Rounds[2].Matches[0].HomePlayer = "webbi"
+	division.Rounds[2].Matches[1].HomePlayer = "alehrosario"
+
+	problems := strings.Join(ValidateRoundRobin(division), "\n")
+
+	expected := []string{
+		"herchu and webbi meet 2 times, expected 1",
+		"herchu and alehrosario meet 0 times, expected 1",
+		"webbi and Lord Trooper meet 0 times, expected 1",
+		"Lord Trooper and alehrosario meet 2 times, expected 1",
+	}
+	for _, want := range expected {
+		if !strings.Contains(problems, want) {
+			t.Errorf("Expected problem %q, got:\n%s", want, problems)
+		}
+	}
+}
+
+func TestValidateRoundRobin_MissingMatch(t *testing.T) {
+	division := fourPlayerRoundRobin()
+	division.Rounds[1].Matches = division.Rounds[1].Matches[:1]
+
+	problems := strings.Join(ValidateRoundRobin(division), "\n")
+
+	for _, want := range []string{
+		"round 2 has 1 matches, expected 2",
+		"webbi and alehrosario meet 0 times, expected 1",
+	} {
+		if !strings.Contains(problems, want) {
+			t.Errorf("Expected problem %q, got:\n%s", want, problems)
+		}
+	}
+}
+
+func TestValidateRoundRobin_PlayerTwiceInRound(t *testing.T) {
+	division := fourPlayerRoundRobin()
+	division.Rounds[0].Matches[1].AwayPlayer = "herchu"
+
+	problems := strings.Join(ValidateRoundRobin(division), "\n")
+	if !strings.Contains(problems, "round 1: herchu plays more than once") {
+		t.Errorf("Expected a player appearing twice in round 1 to be reported, got:\n%s", problems)
+	}
+}
+
+func TestValidateRoundRobin_WrongRoundCount(t *testing.T) {
+	division := fourPlayerRoundRobin()
+	division.Rounds = division.Rounds[:2]
+
+	problems := strings.Join(ValidateRoundRobin(division), "\n")
+	if !strings.Contains(problems, "2 rounds is not a whole round-robin for 4 players") {
+		t.Errorf("Expected the round count to be reported, got:\n%s", problems)
+	}
+}
+
+func TestValidateRoundRobin_TooFewPlayers(t *testing.T) {
+	problems := ValidateRoundRobin(&Division{Name: "Empty"})
+	if len(problems) != 1 {
+		t.Errorf("Expected a single problem for an empty division, got %v", problems)
+	}
+}
+
+func TestValidateDivision_Valid(t *testing.T) {
+	if problems := ValidateDivision(fourPlayerRoundRobin()); len(problems) != 0 {
+		t.Errorf("Expected no problems for a valid fixture, got %v", problems)
+	}
+}
+
+func TestValidateDivision_DuplicateID(t *testing.T) {
+	division := fourPlayerRoundRobin()
+	division.Rounds[2].Matches[0].ID = 3
+
+	problems := ValidateDivision(division)
+	if len(problems) != 2 {
+		t.Fatalf("Expected a duplicate and a skipped number, got %v", problems)
+	}
+	if got := problems[0].Error(); got != "match 3 appears in round 2 and round 3" {
+		t.Errorf("Unexpected duplicate problem: %s", got)
+	}
+	if got := problems[1].Error(); got != "match 5 is missing" {
+		t.Errorf("Unexpected skipped number problem: %s", got)
+	}
+}
+
+func TestValidateDivision_EmptyPlayer(t *testing.T) {
+	division := fourPlayerRoundRobin()
+	// With both players blank the row is not a bye, so the missing names are still reported
+	division.Rounds[1].Matches[1].HomePlayer = ""
+	division.Rounds[1].Matches[1].AwayPlayer = ""
+
+	problems := ValidateDivision(division)
+	if len(problems) != 1 || problems[0].Error() != "match 4 has a missing player name" {
+		t.Errorf("Expected the missing player to be reported, got %v", problems)
+	}
+}
+
+func TestValidateDivision_ByeNeedsNoOpponentOrLink(t *testing.T) {
+	division := threePlayerRoundRobinWithByes()
+	// A bye written with a blank opponent is still a bye, not a missing name
+	division.Rounds[2].Matches[1].AwayPlayer = ""
+
+	if problems := ValidateDivision(division); len(problems) != 0 {
+		t.Errorf("Expected byes to pass validation, got %v", problems)
+	}
+}
+
+func TestValidateDivision_PlayedWithoutLink(t *testing.T) {
+	division := fourPlayerRoundRobin()
+	division.Rounds[0].Matches[0].Played = true
+	_ = division.Rounds[0].Matches[1].MarkWalkover("alehrosario")
+
+	problems := ValidateDivision(division)
+	if len(problems) != 1 || !strings.Contains(problems[0].Error(), "match 1 is played but has no BGA link") {
+		t.Errorf("Expected only the played match without a link to be reported, got %v", problems)
+	}
+}
@@ -0,0 +1,21 @@
+package fixtures
+
+import "fmt"
+
+// MarkWalkover records a walkover win for winner, the no-show being scored as a forfeit
+// The winner is awarded the match without goals, so standings count a win but no game difference
+func (m *Match) MarkWalkover(winner string) error {
+	switch winner {
+	case m.HomePlayer:
+		m.HomeScore, m.AwayScore = 0, ForfeitScore
+	case m.AwayPlayer:
+		m.HomeScore, m.AwayScore = ForfeitScore, 0
+	default:
+		return fmt.Errorf("%s does not play match %d", winner, m.ID)
+	}
+
+	m.Played = true
+	m.Walkover = true
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package fixtures
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMatch_MarkWalkover(t *testing.T) {
+	match := &Match{ID: 3, HomePlayer: "herchu", AwayPlayer: "webbi"}
+
+	if err := match.MarkWalkover("webbi"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !match.Played || !match.Walkover {
+		t.Error("Expected the match to be played as a walkover")
+	}
+
+	if !match.HomeForfeited() || match.AwayScore != 0 {
+		t.Errorf("Expected the no-show home player to forfeit with no goals for the winner, got %d-%d",
+			match.HomeScore, match.AwayScore)
+	}
+
+	division := &Division{Rounds: []*Round{{Number: 1, Matches: []*Match{match}}}}
+	if form := strings.Join(RecentForm(division, "webbi", 5), ""); form != "W" {
+		t.Errorf("Expected the walkover to count as a win, got %q", form)
+	}
+	if form := strings.Join(RecentForm(division, "herchu", 5), ""); form != "L" {
+		t.Errorf("Expected the walkover to count as a loss for the no-show, got %q", form)
+	}
+
+	if err := match.MarkWalkover("Lord Trooper"); err == nil {
+		t.Error("Expected an error for a player outside the match")
+	}
+}
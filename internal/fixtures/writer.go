@@ -0,0 +1,198 @@
+package fixtures
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Column layout of the rows written for a fixture created from scratch
+const (
+	roundHeaderFormat = "Duelo,Fecha %d,,,,%s,Link,,¿Se jugó?,¿Ganó Local?,¿Ganó Visita?"
+	roundSeparator    = ",,,,,,,,,,"
+)
+
+// WriteDivisionFile writes every match of the division to its row in the fixture file
+// An existing file keeps its metadata, round headers and separators, only the match rows are rewritten
+// Rows are matched by position like ParseDivision reads them, since Duelo numbers may repeat
+// A missing file is created with the same layout the fixture spreadsheets export
+// A file with a BOM or in Windows-1252 is written back the same way, and rows keep the division's column mapping
+func WriteDivisionFile(division *Division, filename string) error {
+	data, err := os.ReadFile(filename)
+	if errors.Is(err, fs.ErrNotExist) {
+		return writeNewDivisionFile(division, filename)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read fixture file %s: %w", filename, err)
+	}
+
+	data, encoding, err := decodeFixture(data)
+	if err != nil {
+		return err
+	}
+
+	columns := division.columnMapping()
+
+	var matches []*Match
+	for _, round := range division.Rounds {
+		matches = append(matches, round.Matches...)
+	}
+
+	lines := strings.Split(string(data), "\n")
+	inRound := false
+	next := 0
+
+	for i, line := range lines {
+		content := strings.TrimSuffix(line, "\r")
+		trimmed := strings.TrimSpace(content)
+
+		switch {
+		case strings.HasPrefix(trimmed, "Duelo,Fecha"):
+			inRound = true
+			continue
+		case !inRound || trimmed == "" || strings.Contains(trimmed, roundSeparator):
+			continue
+		}
+
+		if next == len(matches) {
+			return fmt.Errorf("fixture file %s has more match rows than the division", filename)
+		}
+
+		record, err := csv.NewReader(strings.NewReader(trimmed)).Read()
+		if err != nil {
+			return fmt.Errorf("failed to read match row %d of %s: %w", i+1, filename, err)
+		}
+
+		row, err := formatMatchRow(record, matches[next], columns)
+		if err != nil {
+			return err
+		}
+
+		lines[i] = row + strings.TrimPrefix(line, content)
+		next++
+	}
+
+	if next != len(matches) {
+		return fmt.Errorf("fixture file %s has %d match rows, the division has %d", filename, next, len(matches))
+	}
+
+	return writeFixtureLines(filename, lines, encoding)
+}
+
+// writeNewDivisionFile creates a fixture file with a header and separator around each round
+func writeNewDivisionFile(division *Division, filename string) error {
+	columns := division.columnMapping()
+	var lines []string
+
+	for i, round := range division.Rounds {
+		if i > 0 {
+			lines = append(lines, roundSeparator)
+		}
+		lines = append(lines, fmt.Sprintf(roundHeaderFormat, round.Number, round.DateRange))
+
+		for _, match := range round.Matches {
+			record := make([]string, columns.requiredFields())
+			record[columns.ID] = strconv.Itoa(match.ID)
+			record[columns.HomePlayer] = match.HomePlayer
+			record[columns.AwayPlayer] = match.AwayPlayer
+
+			row, err := formatMatchRow(record, match, columns)
+			if err != nil {
+				return err
+			}
+			lines = append(lines, row)
+		}
+	}
+
+	if err := os.WriteFile(filename, []byte(strings.Join(lines, "\n")+"\n"), 0o600); err != nil {
+		return fmt.Errorf("failed to write fixture file %s: %w", filename, err)
+	}
+
+	return nil
+}
+
+// writeFixtureLines replaces the contents of an existing fixture file, keeping its permissions and encoding
+func writeFixtureLines(filename string, lines []string, encoding fixtureEncoding) error {
+	info, err := os.Stat(filename)
+	if err != nil {
+		return fmt.Errorf("failed to stat fixture file %s: %w", filename, err)
+	}
+
+	data, err := encoding.encode([]byte(strings.Join(lines, "\n")))
+	if err != nil {
+		return fmt.Errorf("failed to save fixture file %s: %w", filename, err)
+	}
+
+	if err := os.WriteFile(filename, data, info.Mode().Perm()); err != nil {
+		return fmt.Errorf("failed to write fixture file %s: %w", filename, err)
+	}
+
+	return nil
+}
+
+// formatMatchRow updates the result columns of a fixture row laid out as columns and encodes it back to CSV
+func formatMatchRow(record []string, match *Match, columns ColumnMapping) (string, error) {
+	record = padRecord(record, columns.requiredFields())
+	for _, won := range []*int{columns.HomeWon, columns.AwayWon} {
+		if won != nil {
+			record = padRecord(record, *won+1)
+		}
+	}
+
+	homeWon, awayWon := "0", "0"
+	switch {
+	case !match.Played:
+	case outcome(match.HomeScore, match.AwayScore) == "W":
+		homeWon = "1"
+	case outcome(match.AwayScore, match.HomeScore) == "W":
+		awayWon = "1"
+	}
+
+	record[columns.HomeScore] = FormatScore(match.HomeScore)
+	record[columns.AwayScore] = FormatScore(match.AwayScore)
+	record[columns.DateTime] = match.DateTime
+	record[columns.BGALink] = match.BGALink
+	record[columns.Played] = boolColumn(match.Played)
+	if columns.HomeWon != nil {
+		record[*columns.HomeWon] = homeWon
+	}
+	if columns.AwayWon != nil {
+		record[*columns.AwayWon] = awayWon
+	}
+
+	if detail := columns.ScoreDetail; detail != nil && (len(match.GameScores) > 0 || len(record) > *detail) {
+		record = padRecord(record, *detail+1)
+		record[*detail] = strings.Join(match.GameScores, ", ")
+	}
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	if err := writer.Write(record); err != nil {
+		return "", fmt.Errorf("failed to encode match %d: %w", match.ID, err)
+	}
+	writer.Flush()
+
+	return strings.TrimSuffix(buf.String(), "\n"), writer.Error()
+}
+
+// padRecord appends empty fields until the record has at least n of them
+func padRecord(record []string, n int) []string {
+	for len(record) < n {
+		record = append(record, "")
+	}
+
+	return record
+}
+
+// boolColumn renders a flag the way the fixture spreadsheets do
+func boolColumn(value bool) string {
+	if value {
+		return "1"
+	}
+	return "0"
+}
@@ -0,0 +1,307 @@
+package fixtures
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestWriteDivisionFile_RoundTrip(t *testing.T) {
+	original := "Liga Argentina,Elite,2da Temporada,,,,,,,,,,,\r\n" +
+		"Duelo,Fecha 1,,,,11/08 - 17/08,Detalles,,¿Se jugó?,¿Ganó Local?,¿Ganó Visita?,,,\r\n" +
+		"1,herchu,2,1,Lord Trooper,12/08 - 09:30,https://boardgamearena.com/tournament?id=423761,,1,1,0,,,\r\n" +
+		"2,webbi,0,0,alehrosario,13/08 - 22:00,,,0,0,0,,,\r\n" +
+		",,,,,,,,,,,,,\r\n" +
+		"Duelo,Fecha 2,,,,18/08 - 24/08,Detalles,,¿Se jugó?,¿Ganó Local?,¿Ganó Visita?,,,\r\n" +
+		"2,webbi,0,0,herchu,-,,,0,0,0,,,\r\n"
+
+	filename := filepath.Join(t.TempDir(), "Liga Argentina - 1° Temporada - E-Fixture.csv")
+	if err := os.WriteFile(filename, []byte(original), 0o600); err != nil {
+		t.Fatalf("Failed to write fixture: %v", err)
+	}
+
+	division, err := ParseFixtureFile(filename)
+	if err != nil {
+		t.Fatalf("Failed to parse fixture: %v", err)
+	}
+
+	link := "https://boardgamearena.com/tournament?id=424000"
+	division.Rounds[1].Matches[0].BGALink = link
+
+	if err := WriteDivisionFile(division, filename); err != nil {
+		t.Fatalf("Failed to write division: %v", err)
+	}
+
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("Failed to read fixture: %v", err)
+	}
+
+	want := strings.Replace(original, "2,webbi,0,0,herchu,-,,", "2,webbi,0,0,herchu,-,"+link+",", 1)
+	if string(data) != want {
+		t.Errorf("Expected only the linked row to change, got:\n%s", data)
+	}
+
+	reloaded, err := ParseFixtureFile(filename)
+	if err != nil {
+		t.Fatalf("Failed to parse written fixture: %v", err)
+	}
+
+	if got := reloaded.Rounds[1].Matches[0].BGALink; got != link {
+		t.Errorf("Expected the link to survive a reload, got %q", got)
+	}
+
+	// The repeated Duelo number in the first round keeps its own row
+	if got := reloaded.Rounds[0].Matches[1].BGALink; got != "" {
+		t.Errorf("Expected the first round's Duelo 2 to stay without link, got %q", got)
+	}
+}
+
+func TestWriteDivisionFile_NewFile(t *testing.T) {
+	division := &Division{
+		Rounds: []*Round{
+			{Number: 1, DateRange: "11/08 - 17/08", Matches: []*Match{
+				{ID: 1, HomePlayer: "herchu", AwayPlayer: "webbi", HomeScore: 2, AwayScore: 1, DateTime: "12/08 - 09:30",
+					Played: true},
+			}},
+			{Number: 2, DateRange: "18/08 - 24/08", Matches: []*Match{
+				{ID: 2, HomePlayer: "webbi", AwayPlayer: "herchu", DateTime: "-",
+					BGALink: "https://boardgamearena.com/tournament?id=424000"},
+			}},
+		},
+	}
+
+	filename := filepath.Join(t.TempDir(), "fixture.csv")
+	if err := WriteDivisionFile(division, filename); err != nil {
+		t.Fatalf("Failed to write division: %v", err)
+	}
+
+	reloaded, err := ParseFixtureFile(filename)
+	if err != nil {
+		t.Fatalf("Failed to parse written fixture: %v", err)
+	}
+
+	if len(reloaded.Rounds) != 2 || reloaded.Rounds[1].Number != 2 {
+		t.Fatalf("Expected two rounds to be written, got %+v", reloaded.Rounds)
+	}
+
+	first := reloaded.Rounds[0].Matches[0]
+	if !first.Played || first.HomeScore != 2 || first.AwayScore != 1 || first.DateTime != "12/08 - 09:30" {
+		t.Errorf("Unexpected first match after reload: %+v", first)
+	}
+
+	if got := reloaded.Rounds[1].Matches[0].BGALink; got != division.Rounds[1].Matches[0].BGALink {
+		t.Errorf("Expected the link to be written, got %q", got)
+	}
+}
+
+func TestWriteDivisionFile_RowCountMismatch(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "fixture.csv")
+	content := "Duelo,Fecha 1,,,,,,,,,,\n1,herchu,0,0,webbi,-,,,0,0,0\n"
+	if err := os.WriteFile(filename, []byte(content), 0o600); err != nil {
+		t.Fatalf("Failed to write fixture: %v", err)
+	}
+
+	if err := WriteDivisionFile(&Division{}, filename); err == nil {
+		t.Error("Expected an error when the file has rows the division does not")
+	}
+}
+
+func TestWriteDivisionFile_WalkoverRewritesOnlyItsRow(t *testing.T) {
+	original := "Duelo,Fecha 1,,,,11/08 - 17/08,Detalles,,¿Se jugó?,¿Ganó Local?,¿Ganó Visita?,,,\r\n" +
+		"1,herchu,2,1,Lord Trooper,12/08 - 09:30,https://boardgamearena.com/tournament?id=423761,,1,1,0,,,\r\n" +
+		"2,webbi,0,0,alehrosario,-,,,0,0,0,,,\r\n"
+
+	filename := filepath.Join(t.TempDir(), "Liga Argentina - 1° Temporada - E-Fixture.csv")
+	if err := os.WriteFile(filename, []byte(original), 0o600); err != nil {
+		t.Fatalf("Failed to write fixture: %v", err)
+	}
+
+	division, err := ParseFixtureFile(filename)
+	if err != nil {
+		t.Fatalf("Failed to parse fixture: %v", err)
+	}
+
+	match := division.Rounds[0].Matches[1]
+	if err := match.MarkWalkover("webbi"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if err := WriteDivisionFile(division, division.Filename); err != nil {
+		t.Fatalf("Failed to save match: %v", err)
+	}
+
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("Failed to read fixture: %v", err)
+	}
+
+	untouched := original[:strings.Index(original, "2,webbi")]
+	if !strings.HasPrefix(string(data), untouched) || !strings.HasSuffix(string(data), "\r\n") {
+		t.Errorf("Expected other rows and line endings untouched, got:\n%s", data)
+	}
+
+	lines := strings.Split(string(data), "\r\n")
+	if lines[2] != "2,webbi,0,F,alehrosario,-,,,1,1,0,,," {
+		t.Errorf("Unexpected walkover row: %q", lines[2])
+	}
+
+	reloaded, err := ParseFixtureFile(filename)
+	if err != nil {
+		t.Fatalf("Failed to parse saved fixture: %v", err)
+	}
+
+	saved := reloaded.Rounds[0].Matches[1]
+	if !saved.Played || !saved.Walkover || !saved.AwayForfeited() {
+		t.Errorf("Expected the walkover to survive a reload, got %+v", saved)
+	}
+}
+
+func TestWriteDivisionFile_RepeatedDueloNumberMatchedByPosition(t *testing.T) {
+	original := "Duelo,Fecha 1,,,,11/08 - 17/08,Link,,¿Se jugó?,¿Ganó Local?,¿Ganó Visita?\n" +
+		"1,herchu,0,0,Lord Trooper,,,,0,0,0\n" +
+		"1,webbi,0,0,alehrosario,,,,0,0,0\n"
+
+	filename := filepath.Join(t.TempDir(), "fixture.csv")
+	if err := os.WriteFile(filename, []byte(original), 0o600); err != nil {
+		t.Fatalf("Failed to write fixture: %v", err)
+	}
+
+	division, err := ParseFixtureFile(filename)
+	if err != nil {
+		t.Fatalf("Failed to parse fixture: %v", err)
+	}
+
+	if err := division.Rounds[0].Matches[1].MarkWalkover("alehrosario"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if err := WriteDivisionFile(division, filename); err != nil {
+		t.Fatalf("Failed to save match: %v", err)
+	}
+
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("Failed to read fixture: %v", err)
+	}
+
+	lines := strings.Split(string(data), "\n")
+	if lines[1] != "1,herchu,0,0,Lord Trooper,,,,0,0,0" || lines[2] != "1,webbi,F,0,alehrosario,,,,1,0,1" {
+		t.Errorf("Expected only the second Duelo 1 row changed, got:\n%s", data)
+	}
+}
+
+func TestWriteDivisionFile_KeepsWindows1252(t *testing.T) {
+	original := "Duelo,Fecha 1,,,,11/08 - 17/08,Link,,\xbfSe jug\xf3?,\xbfGan\xf3 Local?,\xbfGan\xf3 Visita?\n" +
+		"1,Nicoooo95,0,0,Joaqu\xedn,,,,0,0,0\n"
+
+	filename := filepath.Join(t.TempDir(), "fixture.csv")
+	if err := os.WriteFile(filename, []byte(original), 0o600); err != nil {
+		t.Fatalf("Failed to write fixture: %v", err)
+	}
+
+	division, err := ParseFixtureFile(filename)
+	if err != nil {
+		t.Fatalf("Failed to parse fixture: %v", err)
+	}
+
+	if err := division.Rounds[0].Matches[0].RecordResult(2, 0); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if err := WriteDivisionFile(division, filename); err != nil {
+		t.Fatalf("Failed to save match: %v", err)
+	}
+
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("Failed to read fixture: %v", err)
+	}
+
+	expected := "Duelo,Fecha 1,,,,11/08 - 17/08,Link,,\xbfSe jug\xf3?,\xbfGan\xf3 Local?,\xbfGan\xf3 Visita?\n" +
+		"1,Nicoooo95,2,0,Joaqu\xedn,,,,1,1,0\n"
+	if string(data) != expected {
+		t.Errorf("Expected the file kept in Windows-1252, got:\n%q", data)
+	}
+}
+
+func TestWriteDivisionFile_KeepsUTF8BOM(t *testing.T) {
+	original := "\ufeffDuelo,Fecha 1,,,,11/08 - 17/08,Link,,¿Se jugó?,¿Ganó Local?,¿Ganó Visita?\n" +
+		"1,herchu,0,0,Joaquín,,,,0,0,0\n"
+
+	filename := filepath.Join(t.TempDir(), "fixture.csv")
+	if err := os.WriteFile(filename, []byte(original), 0o600); err != nil {
+		t.Fatalf("Failed to write fixture: %v", err)
+	}
+
+	division, err := ParseFixtureFile(filename)
+	if err != nil {
+		t.Fatalf("Failed to parse fixture: %v", err)
+	}
+
+	if err := division.Rounds[0].Matches[0].RecordResult(0, 2); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if err := WriteDivisionFile(division, filename); err != nil {
+		t.Fatalf("Failed to save match: %v", err)
+	}
+
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("Failed to read fixture: %v", err)
+	}
+
+	expected := "\ufeffDuelo,Fecha 1,,,,11/08 - 17/08,Link,,¿Se jugó?,¿Ganó Local?,¿Ganó Visita?\n" +
+		"1,herchu,0,2,Joaquín,,,,1,0,1\n"
+	if string(data) != expected {
+		t.Errorf("Expected the BOM and the UTF-8 text kept, got:\n%q", data)
+	}
+}
+
+func TestWriteDivisionFile_KeepsCustomColumnMapping(t *testing.T) {
+	original := "Duelo,Fecha 1,,,,11/08 - 17/08,Link,\n" +
+		"herchu,Lord Trooper,2,1,1,12/08 - 09:30,https://boardgamearena.com/tournament?id=423761,1\n" +
+		"webbi,alehrosario,0,0,0,,,2\n"
+
+	filename := filepath.Join(t.TempDir(), "Liga Argentina - 1° Temporada - E-Fixture.csv")
+	if err := os.WriteFile(filename, []byte(original), 0o600); err != nil {
+		t.Fatalf("Failed to write fixture: %v", err)
+	}
+
+	division, err := ParseFixtureFile(filename, WithColumnMapping(reorderedMapping))
+	if err != nil {
+		t.Fatalf("Failed to parse fixture: %v", err)
+	}
+
+	match := division.Rounds[0].Matches[1]
+	match.HomeScore, match.Played = 2, true
+	match.DateTime = "14/08 - 21:00"
+	match.BGALink = "https://boardgamearena.com/tournament?id=424000"
+
+	if err := WriteDivisionFile(division, filename); err != nil {
+		t.Fatalf("Failed to write division: %v", err)
+	}
+
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("Failed to read fixture: %v", err)
+	}
+
+	want := strings.Replace(original, "webbi,alehrosario,0,0,0,,,2",
+		"webbi,alehrosario,2,0,1,14/08 - 21:00,https://boardgamearena.com/tournament?id=424000,2", 1)
+	if string(data) != want {
+		t.Errorf("Expected the result written in the mapped columns, got:\n%s", data)
+	}
+
+	reloaded, err := ParseFixtureFile(filename, WithColumnMapping(reorderedMapping))
+	if err != nil {
+		t.Fatalf("Failed to parse written fixture: %v", err)
+	}
+
+	got := reloaded.Rounds[0].Matches[1]
+	if !got.Played || got.HomeScore != 2 || got.ID != 2 || got.BGALink != match.BGALink {
+		t.Errorf("Expected the result to survive a reload, got %+v", got)
+	}
+}